@@ -0,0 +1,237 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openconfig/ygnmi/ygnmi"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Outcome is the outcome of a validator in a batch.
+type Outcome int
+
+const (
+	// Passed is the outcome of a validator that passed.
+	Passed Outcome = iota
+	// Failed is the outcome of a validator that failed when checked, or
+	// whose values could not be fetched.
+	Failed
+	// TimedOut is the outcome of a validator that had not passed by the
+	// deadline.
+	TimedOut
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Passed:
+		return "PASSED"
+	case Failed:
+		return "FAILED"
+	case TimedOut:
+		return "TIMED OUT"
+	}
+	return fmt.Sprintf("Outcome(%d)", int(o))
+}
+
+// Result is the result of a validator in a batch.
+type Result struct {
+	// Path is the path of the validator, as returned by its Path method.
+	Path    string
+	Outcome Outcome
+	// Value is the last value of the path, as formatted by FormatValue, or the
+	// last value of each path of a combined validator.  It is empty for
+	// validators not created by this package.
+	Value string
+	// Err is the error of the validator, or nil if it passed.
+	Err error
+}
+
+// Report is the report of a batch, with the results of its validators in the
+// order they were added.
+type Report struct {
+	Results []*Result
+}
+
+// Err returns an error listing the validators that did not pass, or nil if
+// all of them passed.
+func (r *Report) Err() error {
+	var b strings.Builder
+	var failed int
+	for _, res := range r.Results {
+		if res.Outcome != Passed {
+			failed++
+			fmt.Fprintf(&b, "\n%s: %v", res.Outcome, res.Err)
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d validators did not pass:%s", failed, len(r.Results), b.String())
+}
+
+// String returns a table of the outcome, path, last value and error of every
+// validator.
+func (r *Report) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "OUTCOME\tPATH\tVALUE\tERROR")
+	for _, res := range r.Results {
+		var errStr string
+		if res.Err != nil {
+			errStr = strings.ReplaceAll(res.Err.Error(), "\n", "; ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", res.Outcome, res.Path, res.Value, errStr)
+	}
+	w.Flush()
+	return b.String()
+}
+
+// Batch validates many paths of a target concurrently.  The queries of the
+// validators created by this package are served from a single gNMI
+// subscription to all their paths, rather than a subscription for each one.  Other validators, or combinations
+// including them, are validated with their own subscriptions.
+type Batch struct {
+	client     gpb.GNMIClient
+	target     string
+	validators []Validator
+}
+
+// NewBatch returns a batch of the validators of paths of the target, which
+// are queried with the gNMI client.
+func NewBatch(client gpb.GNMIClient, target string, validators ...Validator) *Batch {
+	return &Batch{client: client, target: target, validators: validators}
+}
+
+// Add adds validators to the batch.
+func (b *Batch) Add(validators ...Validator) {
+	b.validators = append(b.validators, validators...)
+}
+
+// Check validates the current values of the paths, and reports the results.
+func (b *Batch) Check() *Report {
+	return b.run(context.Background(), true)
+}
+
+// Await waits for every validator to pass, until the context is done, and
+// reports the results.  Unlike awaiting each validator in turn, a validator
+// that does not pass does not delay or prevent the others.
+func (b *Batch) Await(ctx context.Context) *Report {
+	return b.run(ctx, false)
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout. If timeout
+// is <= 0, this is equivalent to Check().
+func (b *Batch) AwaitFor(timeout time.Duration) *Report {
+	if timeout <= 0 {
+		return b.Check()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return b.Await(ctx)
+}
+
+// AwaitUntil calls Await with a context with the given deadline. If deadline
+// is in the past, this is equivalent to Check().
+func (b *Batch) AwaitUntil(deadline time.Time) *Report {
+	if deadline.Before(time.Now()) {
+		return b.Check()
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	return b.Await(ctx)
+}
+
+func (b *Batch) run(ctx context.Context, check bool) *Report {
+	report := &Report{Results: make([]*Result, len(b.validators))}
+	direct, err := ygnmi.NewClient(b.client, ygnmi.WithTarget(b.target))
+	if err != nil {
+		b.fail(report, err)
+		return report
+	}
+	watchers := make([]watcher, len(b.validators))
+	var paths []*gpb.Path
+	for i, vd := range b.validators {
+		watchers[i] = asWatcher(vd)
+		paths = append(paths, watchers[i].paths()...)
+	}
+	local := direct
+	if len(paths) > 0 {
+		subCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		f, err := subscribeFanout(subCtx, b.client, b.target, disjointPrefixes(paths))
+		if err == nil {
+			err = f.awaitSync(ctx)
+		}
+		if err != nil {
+			b.fail(report, err)
+			return report
+		}
+		if local, err = ygnmi.NewClient(f, ygnmi.WithTarget(b.target)); err != nil {
+			b.fail(report, err)
+			return report
+		}
+	}
+	var wg sync.WaitGroup
+	for i, w := range watchers {
+		client := local
+		if w.paths() == nil {
+			client = direct
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := &Result{Path: w.Path()}
+			if check {
+				res.Value, res.Err = checkWatcher(w, client)
+			} else {
+				res.Value, res.Err = awaitWatcher(ctx, w, client)
+			}
+			res.Outcome = outcome(res.Err)
+			report.Results[i] = res
+		}()
+	}
+	wg.Wait()
+	return report
+}
+
+// fail reports that every validator failed because of the error.
+func (b *Batch) fail(report *Report, err error) {
+	for i, vd := range b.validators {
+		res := &Result{Path: vd.Path(), Err: &pathError{path: vd.Path(), failureCause: err}}
+		res.Outcome = outcome(res.Err)
+		report.Results[i] = res
+	}
+}
+
+// outcome returns the outcome of a validator with the error.
+func outcome(err error) Outcome {
+	var perr *pathError
+	switch {
+	case err == nil:
+		return Passed
+	case errors.As(err, &perr) && isTimeout(perr.failureCause):
+		return TimedOut
+	}
+	return Failed
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/featureprofiles/internal/check"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakebind"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygnmi/ygnmi"
+)
+
+func TestBatch_FakeDUT(t *testing.T) {
+	dut, err := fakebind.NewDUT(&binding.Dims{
+		Name:  "dut",
+		Ports: map[string]*binding.Port{"port1": {Name: "Ethernet1"}},
+	})
+	if err != nil {
+		t.Fatalf("NewDUT() got error: %v", err)
+	}
+	defer dut.Close()
+	client, err := dut.DialGNMI(context.Background())
+	if err != nil {
+		t.Fatalf("DialGNMI() got error: %v", err)
+	}
+
+	intf := gnmi.OC().Interface("Ethernet1")
+	batch := check.NewBatch(client, "dut",
+		check.Equal(intf.OperStatus().State(), oc.Interface_OperStatus_UP),
+		check.Equal(gnmi.OC().System().Hostname().State(), "dut"),
+		check.Equal(intf.Description().State(), "uplink"),
+	)
+	outcomes := func(r *check.Report) []check.Outcome {
+		var got []check.Outcome
+		for _, res := range r.Results {
+			got = append(got, res.Outcome)
+		}
+		return got
+	}
+
+	report := batch.Check()
+	want := []check.Outcome{check.Passed, check.Passed, check.Failed}
+	if diff := cmp.Diff(want, outcomes(report)); diff != "" {
+		t.Errorf("Check() got unexpected outcomes (-want, +got):\n%s\n%s", diff, report)
+	}
+
+	// The description is reported by the DUT while the batch awaits it.
+	path, _, err := ygnmi.ResolvePath(intf.Description().State().PathStruct())
+	if err != nil {
+		t.Fatalf("ResolvePath() got error: %v", err)
+	}
+	updated := make(chan error, 1)
+	time.AfterFunc(100*time.Millisecond, func() {
+		updated <- dut.GNMI.Update(&gpb.Notification{
+			Timestamp: time.Now().UnixNano(),
+			Update: []*gpb.Update{{
+				Path: path,
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "uplink"}},
+			}},
+		})
+	})
+	report = batch.AwaitFor(10 * time.Second)
+	if err := <-updated; err != nil {
+		t.Fatalf("Update() got error: %v", err)
+	}
+	if err := report.Err(); err != nil {
+		t.Errorf("AwaitFor() got error: %v\n%s", err, report)
+	}
+}
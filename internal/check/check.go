@@ -130,6 +130,53 @@ run because Await(ctx, client) aborts if the ctx has already expired, whereas
 AwaitUntil and AwaitFor will both be equivalent to Check if given a 0 or
 negative timeout or a deadline in the past.
 
+# Combining validators
+
+Validators can be combined into a single Validator:
+
+  - check.AllOf(vds...) checks that every validator passes at the same time.
+  - check.AnyOf(vds...) checks that at least one validator passes.
+  - check.Not(vd) checks that the validator fails.
+  - check.StableFor(vd, duration) checks that the validator passes for the
+    duration without failing in between. Its Check waits for up to the
+    duration for the outcome.
+
+Awaiting a combination watches all of its paths concurrently.
+
+# Batches
+
+To await many validators at once, for example all the telemetry of a feature,
+add them to a Batch:
+
+	batch := check.NewBatch(dut.RawAPIs().GNMI(t), dut.ID(), validators...)
+	report := batch.AwaitFor(time.Minute)
+	t.Log(report)
+	if err := report.Err(); err != nil {
+		t.Error(err)
+	}
+
+The batch subscribes once to the paths of all the validators, and awaits all
+of them concurrently against that subscription, so
+one validator that never passes does not hold up the others. The Report lists
+which paths passed, failed or timed out, with their last values.
+
+# Consistency
+
+The Await methods expect a validation to pass eventually. For stability tests,
+which expect a validation to keep passing, use
+
+	check.ConsistentlyFor(5*time.Minute, vd, client)
+
+which watches the query of the validator in ON_CHANGE mode for the duration,
+and fails as soon as a value fails the validation, with a *check.Violation
+reporting the offending value and its timestamp:
+
+	/network-instances/.../session-state: got ACTIVE, want ESTABLISHED (updated at 2025-01-02T15:04:05.123Z)
+
+Pass ygnmi.WithSubscriptionMode and ygnmi.WithSampleInterval options to
+validate samples instead. Consistently and ConsistentlyUntil take a context or
+a deadline instead of a duration.
+
 # Error Messages
 
 The error messages generated by failing checks will include the path, the value
@@ -153,6 +200,8 @@ import (
 	"github.com/openconfig/ygot/ygot"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 )
 
 // FormatPath formats a PathStruct for display. On unresolvable or otherwise
@@ -233,24 +282,30 @@ func (f *validationError[T]) qStr() string {
 }
 
 func (f *validationError[T]) Error() string {
-	if isTimeout(f.failureCause) {
+	return formatError(f.qStr(), f.validationErr, f.failureCause)
+}
+
+// formatError formats the error of the validation of a path, as described
+// for validationError.
+func formatError(path string, validationErr, failureCause error) string {
+	if isTimeout(failureCause) {
 		// in the special (common) case where Await failed because of a timeout,
 		// print the validation error.
-		if f.validationErr != nil {
-			return fmt.Sprintf("%s: %v (deadline exceeded)", f.qStr(), f.validationErr)
+		if validationErr != nil {
+			return fmt.Sprintf("%s: %v (deadline exceeded)", path, validationErr)
 		}
-		return fmt.Sprintf("%s: deadline exceeded before any values were fetched", f.qStr())
+		return fmt.Sprintf("%s: deadline exceeded before any values were fetched", path)
 	}
 	// If we had a network etc. failure, print that (ignoring validation errors)
-	if f.failureCause != nil {
-		return fmt.Sprintf("%s: %v", f.qStr(), f.failureCause)
+	if failureCause != nil {
+		return fmt.Sprintf("%s: %v", path, failureCause)
 	}
 	// Otherwise print the validation error
-	if f.validationErr != nil {
-		return fmt.Sprintf("%s: %v", f.qStr(), f.validationErr)
+	if validationErr != nil {
+		return fmt.Sprintf("%s: %v", path, validationErr)
 	}
 	// In theory this shouldn't happen.
-	return fmt.Sprintf("%s: unknown error", f.qStr())
+	return fmt.Sprintf("%s: unknown error", path)
 }
 
 var _ error = (*validationError[any])(nil)
@@ -367,6 +422,46 @@ func (vd *validation[T]) AwaitUntil(deadline time.Time, client *ygnmi.Client) er
 	return vd.Await(ctx, client)
 }
 
+// watch reports the value of the query and the result of its validation,
+// for the current value and every change of it, until report returns false.
+func (vd *validation[T]) watch(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+	// Fetch the current value regardless of the context, as Await does.
+	v, err := ygnmi.Lookup(context.Background(), client, vd.query)
+	if err != nil {
+		return err
+	}
+	if !report(FormatValue(v), vd.validationFn(v)) {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	watcher := ygnmi.Watch(ctx, client, vd.query, func(v *ygnmi.Value[T]) error {
+		if report(FormatValue(v), vd.validationFn(v)) {
+			return ygnmi.Continue
+		}
+		return nil
+	})
+	_, err = watcher.Await()
+	return err
+}
+
+// paths returns the path of the query, or nothing if it cannot be resolved.
+func (vd *validation[T]) paths() []*gpb.Path {
+	path, opts, err := ygnmi.ResolvePath(vd.query.PathStruct())
+	if err != nil {
+		return nil
+	}
+	if origin, ok := opts[ygnmi.OriginOverride].(string); ok {
+		path.Origin = origin
+	}
+	// Like ygnmi, default to the openconfig origin except for metadata.
+	if path.GetOrigin() == "" && (len(path.GetElem()) == 0 || path.GetElem()[0].GetName() != "meta") {
+		path.Origin = "openconfig"
+	}
+	return []*gpb.Path{{Origin: path.GetOrigin(), Elem: path.GetElem()}}
+}
+
 // Validate expects validationFn to return no error on the query's value.
 func Validate[T any, QT ygnmi.SingletonQuery[T]](query QT, validationFn func(*ygnmi.Value[T]) error) Validator {
 	return &validation[T]{query, validationFn}
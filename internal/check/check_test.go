@@ -15,11 +15,13 @@ package check_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/openconfig/featureprofiles/internal/check"
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 	"github.com/openconfig/gnmi/testing/fake/gnmi"
@@ -39,6 +41,7 @@ var (
 // methods to stub data in it.
 type fakeGNMI struct {
 	Agent        *gnmi.Agent
+	GNMI         gpb.GNMIClient
 	Client       *ygnmi.Client
 	gen          *fpb.FixedGenerator
 	childTwoPath *gpb.Path
@@ -67,13 +70,15 @@ func newFakeGNMI(ctx context.Context) (*fakeGNMI, error) {
 		return nil, fmt.Errorf("NewClient(%s): %w", agent.Address(), err)
 	}
 
-	client, err := ygnmi.NewClient(gpb.NewGNMIClient(conn))
+	gnmiClient := gpb.NewGNMIClient(conn)
+	client, err := ygnmi.NewClient(gnmiClient)
 	if err != nil {
 		return nil, err
 	}
 
 	return &fakeGNMI{
 		Agent:        agent,
+		GNMI:         gnmiClient,
 		Client:       client,
 		gen:          gen,
 		childTwoPath: gChildTwo,
@@ -335,6 +340,210 @@ func TestNetworkErrors(t *testing.T) {
 	}
 }
 
+func TestCombinators(t *testing.T) {
+	fakeGNMI, c := mustNewFakeGNMI(context.Background(), t)
+	defer fakeGNMI.Close()
+	query := childTwo.State()
+	testCases := []struct {
+		desc        string
+		validator   check.Validator
+		value       string
+		errIncludes []string
+	}{{
+		desc:      "AllOf/Correct",
+		validator: check.AllOf(check.Equal(query, "correct"), check.Present[string](query)),
+		value:     "correct",
+	}, {
+		desc:        "AllOf/Incorrect",
+		validator:   check.AllOf(check.Equal(query, "correct"), check.Present[string](query)),
+		value:       "wrong",
+		errIncludes: []string{"AllOf(" + childTwoStatePath, "correct", "wrong"},
+	}, {
+		desc:      "AnyOf/Correct",
+		validator: check.AnyOf(check.Equal(query, "correct"), check.Equal(query, "other")),
+		value:     "other",
+	}, {
+		desc:        "AnyOf/Incorrect",
+		validator:   check.AnyOf(check.Equal(query, "correct"), check.Equal(query, "other")),
+		value:       "wrong",
+		errIncludes: []string{"AnyOf(" + childTwoStatePath, "correct", "other", "wrong"},
+	}, {
+		desc:      "Not/Correct",
+		validator: check.Not(check.Equal(query, "wrong")),
+		value:     "correct",
+	}, {
+		desc:        "Not/Incorrect",
+		validator:   check.Not(check.Equal(query, "wrong")),
+		value:       "wrong",
+		errIncludes: []string{"Not(" + childTwoStatePath + ")", "wrong", "to fail"},
+	}, {
+		desc:      "Nested/Correct",
+		validator: check.AllOf(check.Not(check.NotPresent[string](query)), check.AnyOf(check.Equal(query, "correct"))),
+		value:     "correct",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fakeGNMI.stubChildTwo(update{tc.value, 0})
+			gotErr := tc.validator.Check(c)
+			if len(tc.errIncludes) > 0 {
+				if err := errContainsAll(gotErr, tc.errIncludes); err != nil {
+					t.Error(err)
+				}
+			} else if gotErr != nil {
+				t.Errorf("Unexpected error: %v", gotErr)
+			}
+		})
+	}
+}
+
+func TestStableFor(t *testing.T) {
+	fakeGNMI, c := mustNewFakeGNMI(context.Background(), t)
+	defer fakeGNMI.Close()
+	vd := check.StableFor(check.Equal(childTwo.State(), "correct"), 50*time.Millisecond)
+	testCases := []struct {
+		desc        string
+		updates     []update
+		errIncludes []string
+	}{{
+		desc:    "Stable",
+		updates: []update{{"correct", 0}, {"correct", time.Hour}},
+	}, {
+		desc:        "Flapping",
+		updates:     []update{{"correct", 0}, {"wrong", 0}, {"correct", time.Hour}},
+		errIncludes: []string{"StableFor(" + childTwoStatePath + ", 50ms)", "wrong", "correct"},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.desc+"/Check", func(t *testing.T) {
+			fakeGNMI.stubChildTwo(tc.updates...)
+			gotErr := vd.Check(c)
+			if len(tc.errIncludes) > 0 {
+				if err := errContainsAll(gotErr, tc.errIncludes); err != nil {
+					t.Error(err)
+				}
+			} else if gotErr != nil {
+				t.Errorf("Unexpected error: %v", gotErr)
+			}
+		})
+	}
+	t.Run("Not yet stable", func(t *testing.T) {
+		fakeGNMI.stubChildTwo(update{"correct", 0}, update{"correct", time.Hour})
+		gotErr := vd.AwaitFor(time.Millisecond*10, c)
+		if err := errContainsAll(gotErr, []string{"correct", "not yet for 50ms", "deadline"}); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestBatch(t *testing.T) {
+	fakeGNMI, _ := mustNewFakeGNMI(context.Background(), t)
+	defer fakeGNMI.Close()
+	query := childTwo.State()
+	batch := check.NewBatch(fakeGNMI.GNMI, "", check.Equal(query, "correct"), check.Present[string](query))
+	batch.Add(check.AllOf(check.NotEqual(query, "other"), check.Present[string](query)))
+
+	outcomes := func(r *check.Report) []check.Outcome {
+		var got []check.Outcome
+		for _, res := range r.Results {
+			got = append(got, res.Outcome)
+		}
+		return got
+	}
+	t.Run("Check", func(t *testing.T) {
+		fakeGNMI.stubChildTwo(update{"wrong", 0})
+		report := batch.Check()
+		want := []check.Outcome{check.Failed, check.Passed, check.Passed}
+		if diff := cmp.Diff(want, outcomes(report)); diff != "" {
+			t.Errorf("Check() got unexpected outcomes (-want,+got):\n%s\n%v", diff, report)
+		}
+		if got, want := report.Results[0].Value, `"wrong"`; got != want {
+			t.Errorf("Check() got value %s, want %s", got, want)
+		}
+		if err := errContainsAll(report.Err(), []string{"1 of 3", childTwoStatePath, "wrong", "correct"}); err != nil {
+			t.Error(err)
+		}
+	})
+	t.Run("AwaitFor", func(t *testing.T) {
+		fakeGNMI.stubChildTwo(update{"wrong", 0}, update{"correct", time.Hour})
+		report := batch.AwaitFor(time.Millisecond * 500)
+		want := []check.Outcome{check.TimedOut, check.Passed, check.Passed}
+		if diff := cmp.Diff(want, outcomes(report)); diff != "" {
+			t.Errorf("AwaitFor() got unexpected outcomes (-want,+got):\n%s\n%v", diff, report)
+		}
+		if err := errContainsAll(report.Results[0].Err, []string{childTwoStatePath, "wrong", "deadline"}); err != nil {
+			t.Error(err)
+		}
+		if got := report.String(); !strings.Contains(got, "TIMED OUT") {
+			t.Errorf("String() got %q, want TIMED OUT", got)
+		}
+	})
+	t.Run("AwaitFor/all pass", func(t *testing.T) {
+		fakeGNMI.stubChildTwo(update{"wrong", 0}, update{"correct", 0}, update{"correct", time.Hour})
+		if err := batch.AwaitFor(time.Second * 5).Err(); err != nil {
+			t.Errorf("AwaitFor() got error: %v", err)
+		}
+	})
+}
+
+func TestConsistently(t *testing.T) {
+	fakeGNMI, c := mustNewFakeGNMI(context.Background(), t)
+	defer fakeGNMI.Close()
+	query := childTwo.State()
+	testCases := []struct {
+		desc          string
+		validator     check.Validator
+		updates       []update
+		wantViolation string
+		errIncludes   []string
+	}{{
+		desc:      "Consistent",
+		validator: check.Equal(query, "correct"),
+		updates:   []update{{"correct", 0}, {"correct", 0}, {"correct", time.Hour}},
+	}, {
+		desc:          "Violated",
+		validator:     check.Equal(query, "correct"),
+		updates:       []update{{"correct", 0}, {"wrong", 0}, {"correct", time.Hour}},
+		wantViolation: `"wrong"`,
+		errIncludes:   []string{childTwoStatePath, "wrong", "correct", "updated at"},
+	}, {
+		desc:          "Initially violated",
+		validator:     check.NotPresent[string](query),
+		updates:       []update{{"correct", 0}, {"correct", time.Hour}},
+		wantViolation: `"correct"`,
+		errIncludes:   []string{childTwoStatePath, "no value", "correct"},
+	}, {
+		desc:        "Combination",
+		validator:   check.AllOf(check.Equal(query, "correct")),
+		updates:     []update{{"correct", time.Hour}},
+		errIncludes: []string{"AllOf(" + childTwoStatePath + ")", "single query"},
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fakeGNMI.stubChildTwo(tc.updates...)
+			gotErr := check.ConsistentlyFor(time.Millisecond*200, tc.validator, c)
+			if len(tc.errIncludes) > 0 {
+				if err := errContainsAll(gotErr, tc.errIncludes); err != nil {
+					t.Error(err)
+				}
+			} else if gotErr != nil {
+				t.Errorf("Unexpected error: %v", gotErr)
+			}
+			var violation *check.Violation
+			if !errors.As(gotErr, &violation) {
+				if tc.wantViolation != "" {
+					t.Errorf("ConsistentlyFor() got error %v, want a violation", gotErr)
+				}
+				return
+			}
+			if violation.Value != tc.wantViolation {
+				t.Errorf("ConsistentlyFor() got violation of value %s, want %s", violation.Value, tc.wantViolation)
+			}
+			if violation.Timestamp.IsZero() {
+				t.Errorf("ConsistentlyFor() got violation with no timestamp")
+			}
+		})
+	}
+}
+
 func TestFormatValue(t *testing.T) {
 	x := &ygnmi.Value[string]{}
 	x.SetVal("x")
@@ -0,0 +1,447 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openconfig/ygnmi/ygnmi"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// watcher is a Validator that reports the validation of every value of its
+// queries, so that it can be combined with others and awaited in a batch.
+type watcher interface {
+	Validator
+	// watch reports the current value of the queries and the result of its
+	// validation, and again whenever they change, until report returns false
+	// or the context is done.  Values are fetched at least once regardless of
+	// the context.  It returns nil if report returned false, or else the error
+	// that ended the watch.
+	watch(ctx context.Context, client *ygnmi.Client, report func(value string, err error) bool) error
+	// paths returns the paths of the queries, or nil if they are not known.
+	paths() []*gpb.Path
+}
+
+var (
+	_ watcher = (*validation[any])(nil)
+	_ watcher = (*combined)(nil)
+	_ watcher = opaque{}
+)
+
+// asWatcher returns the validator as a watcher.
+func asWatcher(vd Validator) watcher {
+	if w, ok := vd.(watcher); ok {
+		return w
+	}
+	return opaque{vd}
+}
+
+// opaque is a Validator not created by this package, which is watched with
+// its Check and Await methods and reports no values.
+type opaque struct {
+	Validator
+}
+
+func (o opaque) watch(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+	err := o.Check(client)
+	for report("", o.relError(err)) {
+		// Once passed, the validator is assumed to keep passing.
+		if err == nil {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err = o.Await(ctx, client); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return o.relError(err)
+		}
+	}
+	return nil
+}
+
+// relError returns the error without the path of the validator, which is
+// added by the caller of watch.
+func (o opaque) relError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.New(strings.TrimPrefix(err.Error(), o.Path()+": "))
+}
+
+func (o opaque) paths() []*gpb.Path {
+	return nil
+}
+
+// pathError is an error of a watcher, like validationError.
+type pathError struct {
+	path          string
+	validationErr error
+	failureCause  error
+}
+
+func (e *pathError) Error() string {
+	return formatError(e.path, e.validationErr, e.failureCause)
+}
+
+// checkWatcher validates the current values of the watcher, waiting for a
+// result that is not pending, and returns the last value and the error of the
+// validation.
+func checkWatcher(w watcher, client *ygnmi.Client) (string, error) {
+	var value string
+	var verr error
+	if err := w.watch(context.Background(), client, func(v string, err error) bool {
+		value, verr = v, err
+		return pending(err)
+	}); err != nil {
+		return value, &pathError{path: w.Path(), failureCause: err}
+	}
+	if verr != nil {
+		return value, &pathError{path: w.Path(), validationErr: verr}
+	}
+	return value, nil
+}
+
+// awaitWatcher waits for the validation of the values of the watcher to pass,
+// and returns the last value and the error of the validation.
+func awaitWatcher(ctx context.Context, w watcher, client *ygnmi.Client) (string, error) {
+	var value string
+	var verr error
+	if err := w.watch(ctx, client, func(v string, err error) bool {
+		value, verr = v, err
+		return err != nil
+	}); err != nil {
+		return value, &pathError{path: w.Path(), validationErr: verr, failureCause: err}
+	}
+	return value, nil
+}
+
+// combined is a Validator that combines other validators.
+type combined struct {
+	op      string
+	vds     []watcher
+	arg     string // Argument of the combination other than the validators.
+	watchFn func(context.Context, *ygnmi.Client, func(string, error) bool) error
+}
+
+func combine(op string, vds []Validator) *combined {
+	c := &combined{op: op}
+	for _, vd := range vds {
+		c.vds = append(c.vds, asWatcher(vd))
+	}
+	return c
+}
+
+func (c *combined) describe(names []string) string {
+	if c.arg != "" {
+		names = append(names, c.arg)
+	}
+	return fmt.Sprintf("%s(%s)", c.op, strings.Join(names, ", "))
+}
+
+// Path returns a string representation of the combination of the paths being
+// validated.
+func (c *combined) Path() string {
+	var names []string
+	for _, vd := range c.vds {
+		names = append(names, vd.Path())
+	}
+	return c.describe(names)
+}
+
+// RelPath returns a string representation of the combination of the paths
+// being validated, relative to some base.
+func (c *combined) RelPath(base ygnmi.PathStruct) string {
+	var names []string
+	for _, vd := range c.vds {
+		names = append(names, vd.RelPath(base))
+	}
+	return c.describe(names)
+}
+
+func (c *combined) watch(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+	return c.watchFn(ctx, client, report)
+}
+
+func (c *combined) paths() []*gpb.Path {
+	var paths []*gpb.Path
+	for _, vd := range c.vds {
+		p := vd.paths()
+		if p == nil {
+			return nil
+		}
+		paths = append(paths, p...)
+	}
+	return paths
+}
+
+// Check tests the combined validation condition immediately and returns an
+// error if it fails.  If the condition includes a StableFor, Check waits for
+// the outcome of it.
+func (c *combined) Check(client *ygnmi.Client) error {
+	_, err := checkWatcher(c, client)
+	return err
+}
+
+// Await waits for the combined validation condition to pass; it returns nil
+// when it does so or an error if anything goes wrong.
+func (c *combined) Await(ctx context.Context, client *ygnmi.Client) error {
+	_, err := awaitWatcher(ctx, c, client)
+	return err
+}
+
+// AwaitFor calls Await with a context with deadline now + timeout. If timeout
+// is <= 0, this is equivalent to Check().
+func (c *combined) AwaitFor(timeout time.Duration, client *ygnmi.Client) error {
+	if timeout <= 0 {
+		return c.Check(client)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return c.Await(ctx, client)
+}
+
+// AwaitUntil calls Await with a context with the given deadline. If deadline
+// is in the past, this is equivalent to Check().
+func (c *combined) AwaitUntil(deadline time.Time, client *ygnmi.Client) error {
+	if deadline.Before(time.Now()) {
+		return c.Check(client)
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	return c.Await(ctx, client)
+}
+
+// result is the last result reported by a watcher.
+type result struct {
+	path  string
+	value string
+	err   error
+}
+
+// watchEach watches the validators concurrently and reports the combination
+// of their last results whenever one of them changes, once each has reported.
+func watchEach(ctx context.Context, client *ygnmi.Client, vds []watcher, combineFn func([]*result) error, report func(string, error) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu      sync.Mutex
+		results = make([]*result, len(vds))
+		seen    int
+		done    bool // Whether report returned false.
+	)
+	errc := make(chan error, len(vds))
+	for i, vd := range vds {
+		go func() {
+			errc <- vd.watch(ctx, client, func(value string, err error) bool {
+				mu.Lock()
+				defer mu.Unlock()
+				if done {
+					return false
+				}
+				if results[i] == nil {
+					seen++
+				}
+				results[i] = &result{path: vd.Path(), value: value, err: err}
+				if seen < len(vds) {
+					return true
+				}
+				var values []string
+				for _, r := range results {
+					values = append(values, fmt.Sprintf("%s: %s", r.path, r.value))
+				}
+				if !report(strings.Join(values, "; "), combineFn(results)) {
+					done = true
+					cancel()
+					return false
+				}
+				return true
+			})
+		}()
+	}
+	var firstErr error
+	for range vds {
+		if err := <-errc; err != nil && firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if done {
+		return nil
+	}
+	return firstErr
+}
+
+// joinErrors returns the errors of the results, prefixed with their paths.
+func joinErrors(results []*result) error {
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.path, r.err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// AllOf expects all of the validators to pass at the same time.
+func AllOf(vds ...Validator) Validator {
+	c := combine("AllOf", vds)
+	c.watchFn = func(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+		return watchEach(ctx, client, c.vds, joinErrors, report)
+	}
+	return c
+}
+
+// AnyOf expects at least one of the validators to pass.
+func AnyOf(vds ...Validator) Validator {
+	c := combine("AnyOf", vds)
+	c.watchFn = func(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+		return watchEach(ctx, client, c.vds, func(results []*result) error {
+			for _, r := range results {
+				if r.err == nil {
+					return nil
+				}
+			}
+			return joinErrors(results)
+		}, report)
+	}
+	return c
+}
+
+// Not expects the validator to fail.  The values must still be fetched: if
+// they cannot be, Not fails too.
+func Not(vd Validator) Validator {
+	c := combine("Not", []Validator{vd})
+	w := c.vds[0]
+	c.watchFn = func(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+		return w.watch(ctx, client, func(value string, err error) bool {
+			switch {
+			case pending(err):
+				return report(value, err)
+			case err != nil:
+				return report(value, nil)
+			}
+			return report(value, fmt.Errorf("got %s, want the validation of %s to fail", value, w.Path()))
+		})
+	}
+	return c
+}
+
+// StableFor expects the validator to pass for the duration without failing in
+// between.  Check waits for up to the duration for the outcome.
+func StableFor(vd Validator, d time.Duration) Validator {
+	c := combine("StableFor", []Validator{vd})
+	c.arg = d.String()
+	w := c.vds[0]
+	c.watchFn = func(ctx context.Context, client *ygnmi.Client, report func(string, error) bool) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		results := make(chan *result)
+		stop := make(chan struct{})
+		defer close(stop)
+		errc := make(chan error, 1)
+		go func() {
+			errc <- w.watch(ctx, client, func(value string, err error) bool {
+				select {
+				case results <- &result{value: value, err: err}:
+					return true
+				case <-stop:
+					return false
+				}
+			})
+		}()
+		var (
+			last    *result
+			passing bool
+			timer   = time.NewTimer(d)
+			stable  <-chan time.Time
+		)
+		timer.Stop()
+		defer timer.Stop()
+		for {
+			var err error
+			select {
+			case last = <-results:
+				switch {
+				case last.err != nil:
+					passing, stable = false, nil
+					timer.Stop()
+					err = last.err
+				case !passing:
+					passing = true
+					timer.Reset(d)
+					stable = timer.C
+					err = &unstableError{value: last.value, d: d}
+				case stable != nil:
+					err = &unstableError{value: last.value, d: d}
+				}
+			case <-stable:
+				stable = nil
+			case err := <-errc:
+				if err == nil {
+					err = ctx.Err()
+				}
+				return err
+			}
+			if !report(last.value, err) {
+				return nil
+			}
+		}
+	}
+	return c
+}
+
+// unstableError is the result of a StableFor validation that has passed, but
+// not yet for the duration.
+type unstableError struct {
+	value string
+	d     time.Duration
+}
+
+func (e *unstableError) Error() string {
+	return fmt.Sprintf("got %s, but not yet for %v", e.value, e.d)
+}
+
+// pending returns whether the error is only that StableFor validations have
+// not passed for long enough yet, so their outcome is still to be decided.
+func pending(err error) bool {
+	switch e := err.(type) {
+	case *unstableError:
+		return true
+	case interface{ Unwrap() []error }:
+		errs := e.Unwrap()
+		for _, err := range errs {
+			if !pending(err) {
+				return false
+			}
+		}
+		return len(errs) > 0
+	case interface{ Unwrap() error }:
+		return pending(e.Unwrap())
+	}
+	return false
+}
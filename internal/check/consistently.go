@@ -0,0 +1,139 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openconfig/ygnmi/ygnmi"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Violation is the error of a validator that did not pass consistently: the
+// first value that failed the validation, and when it was updated.
+type Violation struct {
+	// Path is the path of the validator.
+	Path string
+	// Value is the value that failed, as formatted by FormatValue.
+	Value string
+	// Timestamp is the timestamp of the update of the value, or the time it
+	// was received if the update had none.  It is zero if the value was not
+	// updated, for example if the query never had a value.
+	Timestamp time.Time
+	// Err is the error of the validation.
+	Err error
+}
+
+func (v *Violation) Error() string {
+	if v.Timestamp.IsZero() {
+		return fmt.Sprintf("%s: %v", v.Path, v.Err)
+	}
+	return fmt.Sprintf("%s: %v (updated at %s)", v.Path, v.Err, v.Timestamp.Format(time.RFC3339Nano))
+}
+
+func (v *Violation) Unwrap() error {
+	return v.Err
+}
+
+// consistentValidator is a Validator that can check that every value of its
+// query passes.
+type consistentValidator interface {
+	Validator
+	consistently(ctx context.Context, client *ygnmi.Client, opts ...ygnmi.Option) error
+}
+
+var _ consistentValidator = (*validation[any])(nil)
+
+// consistently watches the query until the context is done, and returns a
+// Violation as soon as a value fails the validation.
+func (vd *validation[T]) consistently(ctx context.Context, client *ygnmi.Client, opts ...ygnmi.Option) error {
+	var (
+		seen      bool
+		violation *Violation
+	)
+	watcher := ygnmi.Watch(ctx, client, vd.query, func(v *ygnmi.Value[T]) error {
+		seen = true
+		if err := vd.validationFn(v); err != nil {
+			ts := v.Timestamp
+			if ts.IsZero() || ts.Unix() == 0 {
+				ts = v.RecvTimestamp
+			}
+			violation = &Violation{Path: vd.Path(), Value: FormatValue(v), Timestamp: ts, Err: err}
+			return nil
+		}
+		return ygnmi.Continue
+	}, opts...)
+	_, err := watcher.Await()
+	switch {
+	case violation != nil:
+		return violation
+	case seen && ctx.Err() != nil:
+		// The window ended without a violation.
+		return nil
+	}
+	return &validationError[T]{
+		query:        vd.query,
+		failureCause: err,
+	}
+}
+
+// Consistently expects every value of the query of the validator to pass the
+// validation until the context is done, starting with the current value.  It
+// returns a *Violation as soon as a value fails, or an error if no value was
+// fetched before the context was done or anything else goes wrong.
+//
+// The query is subscribed in ON_CHANGE mode, unless options for the
+// subscription say otherwise; for example, to validate every sample of a
+// counter:
+//
+//	check.Consistently(ctx, vd, client,
+//		ygnmi.WithSubscriptionMode(gpb.SubscriptionMode_SAMPLE),
+//		ygnmi.WithSampleInterval(10*time.Second))
+//
+// Only the validators of a single query, such as those returned by Validate
+// and Equal, can be checked consistently.
+func Consistently(ctx context.Context, vd Validator, client *ygnmi.Client, opts ...ygnmi.Option) error {
+	cv, ok := vd.(consistentValidator)
+	if !ok {
+		return fmt.Errorf("%s: cannot be validated consistently, as it is not a validator of a single query", vd.Path())
+	}
+	opts = append([]ygnmi.Option{ygnmi.WithSubscriptionMode(gpb.SubscriptionMode_ON_CHANGE)}, opts...)
+	return cv.consistently(ctx, client, opts...)
+}
+
+// ConsistentlyFor calls Consistently with a context with deadline now +
+// window. If window is <= 0, this is equivalent to Check().
+func ConsistentlyFor(window time.Duration, vd Validator, client *ygnmi.Client, opts ...ygnmi.Option) error {
+	if window <= 0 {
+		return vd.Check(client)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), window)
+	defer cancel()
+	return Consistently(ctx, vd, client, opts...)
+}
+
+// ConsistentlyUntil calls Consistently with a context with the given
+// deadline. If deadline is in the past, this is equivalent to Check().
+func ConsistentlyUntil(deadline time.Time, vd Validator, client *ygnmi.Client, opts ...ygnmi.Option) error {
+	if deadline.Before(time.Now()) {
+		return vd.Check(client)
+	}
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	return Consistently(ctx, vd, client, opts...)
+}
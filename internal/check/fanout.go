@@ -0,0 +1,418 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// fanout is a gNMI client that serves Subscribe requests from the latest
+// values of a single upstream subscription, so that many queries can be
+// watched without subscribing to the device for each of them.  Only the
+// Subscribe RPC is implemented.
+type fanout struct {
+	gpb.GNMIClient
+
+	mu      sync.Mutex
+	leaves  map[string]*fanoutLeaf // Latest update of every leaf, by path.
+	streams map[*fanoutStream]bool // Open STREAM subscriptions.
+	err     error                  // Error that ended the upstream subscription.
+	synced  chan struct{}          // Closed on the upstream sync response.
+	done    chan struct{}          // Closed when the upstream subscription ends.
+}
+
+// fanoutLeaf is the latest update of a leaf.
+type fanoutLeaf struct {
+	path      *gpb.Path // Full path of the leaf.
+	timestamp int64
+	prefix    *gpb.Path
+	update    *gpb.Update
+}
+
+// subscribeFanout subscribes to the paths of the target in STREAM mode, and
+// returns a fanout of the subscription.  The subscription ends when the
+// context is done.
+func subscribeFanout(ctx context.Context, client gpb.GNMIClient, target string, paths []*gpb.Path) (*fanout, error) {
+	sub, err := client.Subscribe(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("gNMI failed to Subscribe: %w", err)
+	}
+	list := &gpb.SubscriptionList{
+		Prefix:   &gpb.Path{Target: target},
+		Mode:     gpb.SubscriptionList_STREAM,
+		Encoding: gpb.Encoding_PROTO,
+	}
+	for _, p := range paths {
+		list.Subscription = append(list.Subscription, &gpb.Subscription{Path: p, Mode: gpb.SubscriptionMode_TARGET_DEFINED})
+	}
+	if err := sub.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: list}}); err != nil {
+		return nil, fmt.Errorf("gNMI failed to Send(%v): %w", list, err)
+	}
+	f := newFanout()
+	go f.receive(sub)
+	return f, nil
+}
+
+func newFanout() *fanout {
+	return &fanout{
+		leaves:  make(map[string]*fanoutLeaf),
+		streams: make(map[*fanoutStream]bool),
+		synced:  make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// awaitSync waits for the initial values of the upstream subscription to be
+// synced, and returns an error if the subscription ends first or the context
+// is done.
+func (f *fanout) awaitSync(ctx context.Context) error {
+	select {
+	case <-f.synced:
+		return nil
+	case <-f.done:
+		select {
+		case <-f.synced:
+			return nil
+		default:
+			return f.err
+		}
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// receive receives the responses of the upstream subscription until it ends.
+func (f *fanout) receive(sub gpb.GNMI_SubscribeClient) {
+	var synced bool
+	for {
+		resp, err := sub.Recv()
+		if err != nil {
+			f.end(err)
+			return
+		}
+		switch r := resp.GetResponse().(type) {
+		case *gpb.SubscribeResponse_Update:
+			f.update(r.Update)
+		case *gpb.SubscribeResponse_SyncResponse:
+			if !synced {
+				synced = true
+				close(f.synced)
+			}
+		}
+	}
+}
+
+// end ends the fanout with the error of the upstream subscription.
+func (f *fanout) end(err error) {
+	if err == io.EOF {
+		err = errors.New("gNMI subscription ended")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+	for s := range f.streams {
+		s.wake()
+	}
+	close(f.done)
+}
+
+// update stores the updates of the notification and sends the updates and
+// deletes to the STREAM subscriptions whose paths they match.
+func (f *fanout) update(n *gpb.Notification) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, d := range n.GetDelete() {
+		del := joinPath(n.GetPrefix(), d)
+		for key, l := range f.leaves {
+			if matchPath(del, l.path) {
+				delete(f.leaves, key)
+			}
+		}
+	}
+	for _, u := range n.GetUpdate() {
+		path := joinPath(n.GetPrefix(), u.GetPath())
+		f.leaves[pathKey(path)] = &fanoutLeaf{path: path, timestamp: n.GetTimestamp(), prefix: n.GetPrefix(), update: u}
+	}
+	for s := range f.streams {
+		if fn := s.filter(n); fn != nil {
+			s.send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: fn}})
+		}
+	}
+}
+
+// Subscribe returns a subscription to the latest values of the fanout.
+func (f *fanout) Subscribe(ctx context.Context, _ ...grpc.CallOption) (gpb.GNMI_SubscribeClient, error) {
+	return &fanoutStream{f: f, ctx: ctx, wakec: make(chan struct{}, 1)}, nil
+}
+
+// fanoutStream is a subscription to the latest values of a fanout.
+type fanoutStream struct {
+	grpc.ClientStream
+	f     *fanout
+	ctx   context.Context
+	wakec chan struct{} // Signals that responses were queued.
+
+	// The following fields are protected by the mutex of the fanout.
+	paths []*gpb.Path
+	queue []*gpb.SubscribeResponse
+	once  bool // The subscription ends when the queue is empty.
+}
+
+// Send starts the subscription with the current values of its paths.
+func (s *fanoutStream) Send(req *gpb.SubscribeRequest) error {
+	list := req.GetSubscribe()
+	if list == nil {
+		return status.Errorf(codes.Unimplemented, "only subscription lists are supported")
+	}
+	if list.GetMode() == gpb.SubscriptionList_POLL {
+		return status.Errorf(codes.Unimplemented, "POLL subscriptions are not supported")
+	}
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	for _, sub := range list.GetSubscription() {
+		s.paths = append(s.paths, joinPath(list.GetPrefix(), sub.GetPath()))
+	}
+	var keys []string
+	for key, l := range s.f.leaves {
+		if s.matches(l.path) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		l := s.f.leaves[key]
+		s.queue = append(s.queue, &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: &gpb.Notification{
+			Timestamp: l.timestamp,
+			Prefix:    l.prefix,
+			Update:    []*gpb.Update{l.update},
+		}}})
+	}
+	s.queue = append(s.queue, &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}})
+	if list.GetMode() == gpb.SubscriptionList_ONCE {
+		s.once = true
+	} else {
+		// The consumer may stop calling Recv before the context is done,
+		// and then never see it done, so the stream is removed from the
+		// fanout as soon as the context is done, not in Recv.
+		s.f.streams[s] = true
+		context.AfterFunc(s.ctx, s.remove)
+	}
+	s.wake()
+	return nil
+}
+
+// Recv returns the next response of the subscription.
+func (s *fanoutStream) Recv() (*gpb.SubscribeResponse, error) {
+	for {
+		s.f.mu.Lock()
+		switch {
+		case len(s.queue) > 0:
+			resp := s.queue[0]
+			s.queue = s.queue[1:]
+			s.f.mu.Unlock()
+			return resp, nil
+		case s.once:
+			s.f.mu.Unlock()
+			return nil, io.EOF
+		case s.f.err != nil:
+			err := s.f.err
+			delete(s.f.streams, s)
+			s.f.mu.Unlock()
+			return nil, err
+		}
+		s.f.mu.Unlock()
+		select {
+		case <-s.wakec:
+		case <-s.ctx.Done():
+			s.remove()
+			return nil, status.FromContextError(s.ctx.Err()).Err()
+		}
+	}
+}
+
+// CloseSend does nothing, as the subscription has no more requests to send.
+func (s *fanoutStream) CloseSend() error {
+	return nil
+}
+
+// Context returns the context of the subscription.
+func (s *fanoutStream) Context() context.Context {
+	return s.ctx
+}
+
+// send queues a response.  The mutex of the fanout must be held.
+func (s *fanoutStream) send(resp *gpb.SubscribeResponse) {
+	s.queue = append(s.queue, resp)
+	s.wake()
+}
+
+// remove stops sending responses to the subscription, and drops the
+// responses queued for it.
+func (s *fanoutStream) remove() {
+	s.f.mu.Lock()
+	defer s.f.mu.Unlock()
+	delete(s.f.streams, s)
+	s.queue = nil
+}
+
+func (s *fanoutStream) wake() {
+	select {
+	case s.wakec <- struct{}{}:
+	default:
+	}
+}
+
+func (s *fanoutStream) matches(path *gpb.Path) bool {
+	for _, p := range s.paths {
+		if matchPath(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// filter returns the notification with only the updates and deletes of the
+// paths of the subscription, or nil if there are none.  A delete is kept if
+// it deletes a path of the subscription, or a path under one.
+func (s *fanoutStream) filter(n *gpb.Notification) *gpb.Notification {
+	out := &gpb.Notification{Timestamp: n.GetTimestamp(), Prefix: n.GetPrefix(), Atomic: n.GetAtomic()}
+	for _, d := range n.GetDelete() {
+		del := joinPath(n.GetPrefix(), d)
+		for _, p := range s.paths {
+			if matchPath(p, del) || matchPath(del, p) {
+				out.Delete = append(out.Delete, d)
+				break
+			}
+		}
+	}
+	for _, u := range n.GetUpdate() {
+		if s.matches(joinPath(n.GetPrefix(), u.GetPath())) {
+			out.Update = append(out.Update, u)
+		}
+	}
+	if len(out.Delete) == 0 && len(out.Update) == 0 {
+		return nil
+	}
+	return out
+}
+
+// joinPath returns the path under the prefix.
+func joinPath(prefix, path *gpb.Path) *gpb.Path {
+	origin := path.GetOrigin()
+	if origin == "" {
+		origin = prefix.GetOrigin()
+	}
+	var elems []*gpb.PathElem
+	elems = append(elems, prefix.GetElem()...)
+	elems = append(elems, path.GetElem()...)
+	return &gpb.Path{Origin: origin, Elem: elems}
+}
+
+// pathKey returns a string that identifies the path.
+func pathKey(path *gpb.Path) string {
+	var b strings.Builder
+	b.WriteString(normalOrigin(path.GetOrigin()))
+	b.WriteString(":")
+	for _, e := range path.GetElem() {
+		b.WriteString("/")
+		b.WriteString(e.GetName())
+		var names []string
+		for name := range e.GetKey() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(&b, "[%s=%s]", name, e.GetKey()[name])
+		}
+	}
+	return b.String()
+}
+
+// normalOrigin returns the origin, with the default origin of OpenConfig
+// paths named.
+func normalOrigin(origin string) string {
+	if origin == "" {
+		return "openconfig"
+	}
+	return origin
+}
+
+// matchPath returns whether the path is the query path or under it.  Element
+// names and key values of "*" in the query, and keys missing from it, match
+// any value; an element named "..." matches the rest of the path.
+func matchPath(query, path *gpb.Path) bool {
+	if normalOrigin(query.GetOrigin()) != normalOrigin(path.GetOrigin()) {
+		return false
+	}
+	elems := path.GetElem()
+	for i, qe := range query.GetElem() {
+		if qe.GetName() == "..." {
+			return true
+		}
+		if i >= len(elems) {
+			return false
+		}
+		if qe.GetName() != "*" && qe.GetName() != elems[i].GetName() {
+			return false
+		}
+		for k, v := range qe.GetKey() {
+			if v != "*" && elems[i].GetKey()[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// disjointPrefixes returns the paths, without duplicates or the paths under
+// others, sorted.  A subscription to them gets the updates of all the paths,
+// and no more.
+func disjointPrefixes(paths []*gpb.Path) []*gpb.Path {
+	byKey := make(map[string]*gpb.Path)
+	for _, p := range paths {
+		byKey[pathKey(p)] = p
+	}
+	var keys []string
+	for key, p := range byKey {
+		covered := false
+		for other, q := range byKey {
+			if other != key && matchPath(q, p) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var prefixes []*gpb.Path
+	for _, key := range keys {
+		prefixes = append(prefixes, byKey[key])
+	}
+	return prefixes
+}
@@ -0,0 +1,379 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// path returns a path of the origin and elements, with keys given after the
+// names as "key=value".
+func path(origin string, elems ...string) *gpb.Path {
+	p := &gpb.Path{Origin: origin}
+	for _, e := range elems {
+		if e[0] == '[' {
+			last := p.Elem[len(p.Elem)-1]
+			if last.Key == nil {
+				last.Key = make(map[string]string)
+			}
+			for i := 1; i < len(e)-1; i++ {
+				if e[i] == '=' {
+					last.Key[e[1:i]] = e[i+1 : len(e)-1]
+				}
+			}
+			continue
+		}
+		p.Elem = append(p.Elem, &gpb.PathElem{Name: e})
+	}
+	return p
+}
+
+func TestMatchPath(t *testing.T) {
+	leaf := path("openconfig", "interfaces", "interface", "[name=port1]", "state", "mtu")
+	tests := []struct {
+		desc  string
+		query *gpb.Path
+		want  bool
+	}{{
+		desc:  "same path",
+		query: leaf,
+		want:  true,
+	}, {
+		desc:  "prefix",
+		query: path("", "interfaces", "interface", "[name=port1]"),
+		want:  true,
+	}, {
+		desc:  "root",
+		query: path("openconfig"),
+		want:  true,
+	}, {
+		desc:  "wildcard key",
+		query: path("openconfig", "interfaces", "interface", "[name=*]", "state"),
+		want:  true,
+	}, {
+		desc:  "wildcard name",
+		query: path("openconfig", "interfaces", "*", "[name=port1]", "state", "mtu"),
+		want:  true,
+	}, {
+		desc:  "any descendant",
+		query: path("openconfig", "interfaces", "..."),
+		want:  true,
+	}, {
+		desc:  "other key",
+		query: path("openconfig", "interfaces", "interface", "[name=port2]"),
+	}, {
+		desc:  "other name",
+		query: path("openconfig", "interfaces", "interface", "[name=port1]", "config"),
+	}, {
+		desc:  "longer",
+		query: path("openconfig", "interfaces", "interface", "[name=port1]", "state", "mtu", "x"),
+	}, {
+		desc:  "other origin",
+		query: path("cli", "interfaces"),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := matchPath(tt.query, leaf); got != tt.want {
+				t.Errorf("matchPath(%v) got %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisjointPrefixes(t *testing.T) {
+	tests := []struct {
+		desc  string
+		paths []*gpb.Path
+		want  []*gpb.Path
+	}{{
+		desc:  "single path",
+		paths: []*gpb.Path{path("openconfig", "system", "state", "hostname")},
+		want:  []*gpb.Path{path("openconfig", "system", "state", "hostname")},
+	}, {
+		desc: "siblings",
+		paths: []*gpb.Path{
+			path("openconfig", "interfaces", "interface", "[name=port1]", "state", "oper-status"),
+			path("openconfig", "interfaces", "interface", "[name=port1]", "state", "mtu"),
+		},
+		want: []*gpb.Path{
+			path("openconfig", "interfaces", "interface", "[name=port1]", "state", "mtu"),
+			path("openconfig", "interfaces", "interface", "[name=port1]", "state", "oper-status"),
+		},
+	}, {
+		desc: "duplicates",
+		paths: []*gpb.Path{
+			path("openconfig", "system", "state", "hostname"),
+			path("openconfig", "system", "state", "hostname"),
+		},
+		want: []*gpb.Path{path("openconfig", "system", "state", "hostname")},
+	}, {
+		desc: "path under another",
+		paths: []*gpb.Path{
+			path("openconfig", "interfaces", "interface", "[name=port1]", "state", "mtu"),
+			path("openconfig", "interfaces", "interface", "[name=port1]"),
+		},
+		want: []*gpb.Path{path("openconfig", "interfaces", "interface", "[name=port1]")},
+	}, {
+		desc: "path under wildcard",
+		paths: []*gpb.Path{
+			path("openconfig", "interfaces", "interface", "[name=port1]", "state", "mtu"),
+			path("openconfig", "interfaces", "interface", "[name=*]", "state"),
+		},
+		want: []*gpb.Path{path("openconfig", "interfaces", "interface", "[name=*]", "state")},
+	}, {
+		desc: "unrelated subtrees",
+		paths: []*gpb.Path{
+			path("openconfig", "c", "d"),
+			path("openconfig", "a", "b"),
+		},
+		want: []*gpb.Path{
+			path("openconfig", "a", "b"),
+			path("openconfig", "c", "d"),
+		},
+	}, {
+		desc: "origins",
+		paths: []*gpb.Path{
+			path("openconfig", "system", "state", "hostname"),
+			path("cli", "show", "version"),
+			path("openconfig", "system"),
+		},
+		want: []*gpb.Path{
+			path("cli", "show", "version"),
+			path("openconfig", "system"),
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := disjointPrefixes(tt.paths)
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("disjointPrefixes() got unexpected diff (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeSubscribeClient is an upstream subscription that receives the
+// responses sent on a channel.
+type fakeSubscribeClient struct {
+	grpc.ClientStream
+	ctx       context.Context
+	reqs      []*gpb.SubscribeRequest
+	responses chan *gpb.SubscribeResponse
+}
+
+func (c *fakeSubscribeClient) Send(req *gpb.SubscribeRequest) error {
+	c.reqs = append(c.reqs, req)
+	return nil
+}
+
+func (c *fakeSubscribeClient) Recv() (*gpb.SubscribeResponse, error) {
+	select {
+	case resp, ok := <-c.responses:
+		if !ok {
+			return nil, io.EOF
+		}
+		return resp, nil
+	case <-c.ctx.Done():
+		return nil, status.FromContextError(c.ctx.Err()).Err()
+	}
+}
+
+type fakeGNMIClient struct {
+	gpb.GNMIClient
+	sub *fakeSubscribeClient
+}
+
+func (c *fakeGNMIClient) Subscribe(ctx context.Context, _ ...grpc.CallOption) (gpb.GNMI_SubscribeClient, error) {
+	c.sub.ctx = ctx
+	return c.sub, nil
+}
+
+func notification(prefix *gpb.Path, updates map[string]string, deletes ...*gpb.Path) *gpb.SubscribeResponse {
+	n := &gpb.Notification{Timestamp: 100, Prefix: prefix, Delete: deletes}
+	for name, val := range updates {
+		n.Update = append(n.Update, &gpb.Update{
+			Path: path("", name),
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: val}},
+		})
+	}
+	return &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}}
+}
+
+var syncResponse = &gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}}
+
+// recvAll receives responses from the subscription until the count or an
+// error.
+func recvAll(t *testing.T, sub gpb.GNMI_SubscribeClient, count int) ([]*gpb.SubscribeResponse, error) {
+	t.Helper()
+	var resps []*gpb.SubscribeResponse
+	for len(resps) < count {
+		resp, err := sub.Recv()
+		if err != nil {
+			return resps, err
+		}
+		resps = append(resps, resp)
+	}
+	return resps, nil
+}
+
+func TestFanout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	upstream := &fakeSubscribeClient{responses: make(chan *gpb.SubscribeResponse, 10)}
+	port1 := path("", "interfaces", "interface", "[name=port1]", "state")
+	port2 := path("", "interfaces", "interface", "[name=port2]", "state")
+	upstream.responses <- notification(port1, map[string]string{"description": "one"})
+	upstream.responses <- notification(port2, map[string]string{"description": "two"})
+	upstream.responses <- syncResponse
+
+	prefixes := []*gpb.Path{path("openconfig", "interfaces")}
+	f, err := subscribeFanout(ctx, &fakeGNMIClient{sub: upstream}, "dut", prefixes)
+	if err != nil {
+		t.Fatalf("subscribeFanout() got error: %v", err)
+	}
+	if err := f.awaitSync(ctx); err != nil {
+		t.Fatalf("awaitSync() got error: %v", err)
+	}
+	wantReq := &gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+		Prefix:       &gpb.Path{Target: "dut"},
+		Subscription: []*gpb.Subscription{{Path: prefixes[0]}},
+		Encoding:     gpb.Encoding_PROTO,
+	}}}
+	if diff := cmp.Diff([]*gpb.SubscribeRequest{wantReq}, upstream.reqs, protocmp.Transform()); diff != "" {
+		t.Errorf("Subscribe() sent unexpected diff (-want,+got):\n%s", diff)
+	}
+
+	subscribe := func(mode gpb.SubscriptionList_Mode) gpb.GNMI_SubscribeClient {
+		t.Helper()
+		sub, err := f.Subscribe(ctx)
+		if err != nil {
+			t.Fatalf("Subscribe() got error: %v", err)
+		}
+		if err := sub.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+			Prefix:       &gpb.Path{Target: "dut"},
+			Subscription: []*gpb.Subscription{{Path: path("openconfig", "interfaces", "interface", "[name=port1]", "state", "description")}},
+			Mode:         mode,
+		}}}); err != nil {
+			t.Fatalf("Send() got error: %v", err)
+		}
+		return sub
+	}
+
+	t.Run("ONCE", func(t *testing.T) {
+		sub := subscribe(gpb.SubscriptionList_ONCE)
+		got, err := recvAll(t, sub, 3)
+		if err != io.EOF {
+			t.Errorf("Recv() got error %v, want EOF", err)
+		}
+		want := []*gpb.SubscribeResponse{notification(port1, map[string]string{"description": "one"}), syncResponse}
+		if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("Recv() got unexpected diff (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("STREAM", func(t *testing.T) {
+		sub := subscribe(gpb.SubscriptionList_STREAM)
+		update := notification(port1, map[string]string{"description": "uno", "mtu": "1500"})
+		del := notification(path("", "interfaces"), nil, path("", "interface", "[name=port1]"))
+		upstream.responses <- notification(port2, map[string]string{"description": "dos"})
+		upstream.responses <- update
+		upstream.responses <- del
+		got, err := recvAll(t, sub, 4)
+		if err != nil {
+			t.Fatalf("Recv() got error: %v", err)
+		}
+		want := []*gpb.SubscribeResponse{
+			notification(port1, map[string]string{"description": "one"}),
+			syncResponse,
+			notification(port1, map[string]string{"description": "uno"}),
+			del,
+		}
+		if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("Recv() got unexpected diff (-want,+got):\n%s", diff)
+		}
+
+		close(upstream.responses)
+		if _, err := sub.Recv(); err == nil {
+			t.Errorf("Recv() after the upstream subscription ended got no error")
+		}
+	})
+}
+
+func TestFanoutContext(t *testing.T) {
+	f := newFanout()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if err := f.awaitSync(ctx); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("awaitSync() got error %v, want DeadlineExceeded", err)
+	}
+	sub, err := f.Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("Subscribe() got error: %v", err)
+	}
+	if err := sub.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{}}}); err != nil {
+		t.Fatalf("Send() got error: %v", err)
+	}
+	if _, err := recvAll(t, sub, 2); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Recv() got error %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestFanoutStopReading(t *testing.T) {
+	f := newFanout()
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := f.Subscribe(ctx)
+	if err != nil {
+		t.Fatalf("Subscribe() got error: %v", err)
+	}
+	if err := sub.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+		Subscription: []*gpb.Subscription{{Path: path("openconfig", "system")}},
+	}}}); err != nil {
+		t.Fatalf("Send() got error: %v", err)
+	}
+	if _, err := recvAll(t, sub, 1); err != nil {
+		t.Fatalf("Recv() got error: %v", err)
+	}
+
+	// Stop reading, as ygnmi does once a Watch is done, and then cancel.
+	cancel()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		f.mu.Lock()
+		n := len(f.streams)
+		f.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("fanout still has %d streams after the context was canceled", n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	f.update(notification(path("", "system", "state"), map[string]string{"hostname": "dut"}).GetUpdate())
+	if q := sub.(*fanoutStream).queue; len(q) != 0 {
+		t.Errorf("fanout queued %d responses after the context was canceled", len(q))
+	}
+}
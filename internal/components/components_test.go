@@ -19,6 +19,12 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakebind"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
 )
 
 func TestFindMatchingStrings(t *testing.T) {
@@ -41,3 +47,40 @@ func TestFindMatchingStrings(t *testing.T) {
 		t.Errorf("FindMatchingStrings(%s) returned unexpected diff (-want +got):\n%s", args, diff)
 	}
 }
+
+func TestFindComponentsByType(t *testing.T) {
+	duts := fakebind.Setup(t, nil)
+	dut := ondatra.DUT(t, "dut")
+	for _, c := range []*oc.Component{{
+		Name:          ygot.String("Supervisor1"),
+		Type:          oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CONTROLLER_CARD,
+		OperStatus:    oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+		RedundantRole: oc.Platform_ComponentRedundantRole_PRIMARY,
+	}, {
+		Name:          ygot.String("Supervisor2"),
+		Type:          oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CONTROLLER_CARD,
+		OperStatus:    oc.PlatformTypes_COMPONENT_OPER_STATUS_INACTIVE,
+		RedundantRole: oc.Platform_ComponentRedundantRole_SECONDARY,
+	}, {
+		Name:       ygot.String("LineCard1"),
+		Type:       oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_LINECARD,
+		OperStatus: oc.PlatformTypes_COMPONENT_OPER_STATUS_ACTIVE,
+	}, {
+		Name: ygot.String("Software1"),
+		Type: oc.PlatformTypes_OPENCONFIG_SOFTWARE_COMPONENT_OPERATING_SYSTEM,
+	}} {
+		fakebind.SetState(t, duts["dut"], gnmi.OC().Component(c.GetName()).State(), c)
+	}
+
+	cc := oc.PlatformTypes_OPENCONFIG_HARDWARE_COMPONENT_CONTROLLER_CARD
+	if diff := cmp.Diff([]string{"Supervisor1", "Supervisor2"}, FindComponentsByType(t, dut, cc), cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("FindComponentsByType() got unexpected diff (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"Supervisor1"}, FindActiveComponentsByType(t, dut, cc)); diff != "" {
+		t.Errorf("FindActiveComponentsByType() got unexpected diff (-want +got):\n%s", diff)
+	}
+	standby, active := FindStandbyControllerCard(t, dut, []string{"Supervisor1", "Supervisor2"})
+	if standby != "Supervisor2" || active != "Supervisor1" {
+		t.Errorf("FindStandbyControllerCard() got standby %q and active %q, want Supervisor2 and Supervisor1", standby, active)
+	}
+}
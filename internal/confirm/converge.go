@@ -0,0 +1,225 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confirm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openconfig/featureprofiles/internal/deviations"
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Subtree is the path of a subtree with both config and state queries, such
+// as gnmi.OC().Interface(name) or gnmi.OC().NetworkInstance(ni).Protocol(...).
+type Subtree[T ygot.ValidatedGoStruct] interface {
+	Config() ygnmi.ConfigQuery[T]
+	State() ygnmi.SingletonQuery[T]
+}
+
+const (
+	// minBackoff and maxBackoff bound the time between fetches of the state.
+	minBackoff = 250 * time.Millisecond
+	maxBackoff = 10 * time.Second
+	// fetchTimeout bounds each fetch of the state.
+	fetchTimeout = time.Minute
+)
+
+// AwaitState waits until every leaf set in want, typically the config of a
+// gNMI Set, appears identically in the state of the subtree.  It fetches the
+// state at least once, then with exponential backoff until the timeout, and
+// returns the leaves of the last state fetched that differ from want, which
+// are none if the state converged, and the error of the last fetch if it
+// failed.
+//
+// If the DUT has the missing_value_for_defaults deviation, leaves missing from
+// the state are accepted if want sets them to their default values.  If it
+// has the state_path_unsupported deviation, the config of the subtree is
+// fetched instead of its state.
+func AwaitState[T ygot.ValidatedGoStruct](t testing.TB, dut *ondatra.DUTDevice, subtree Subtree[T], want T, timeout time.Duration) ([]*Change, error) {
+	t.Helper()
+	client, err := ygnmi.NewClient(dut.RawAPIs().GNMI(t), ygnmi.WithTarget(dut.ID()))
+	if err != nil {
+		return nil, err
+	}
+	var query ygnmi.SingletonQuery[T] = subtree.State()
+	if deviations.StatePathsUnsupported(dut) {
+		query = subtree.Config()
+	}
+	return awaitState(client, query, want, deviations.MissingValueForDefaults(dut), timeout)
+}
+
+// awaitState implements AwaitState.  The first fetch is bounded by
+// fetchTimeout alone, so that the state is fetched even if the timeout is not
+// positive; the later fetches are also bounded by the timeout, and one that is
+// cut short by it is ignored in favor of the previous fetch.
+func awaitState[T ygot.ValidatedGoStruct](client *ygnmi.Client, query ygnmi.SingletonQuery[T], want T, missingDefaults bool, timeout time.Duration) ([]*Change, error) {
+	deadline := time.Now().Add(timeout)
+	var changes []*Change
+	fetch := func(timeout time.Duration) error {
+		got, err := lookup(client, query, want, timeout)
+		if err != nil {
+			return err
+		}
+		changes, err = DiffState(want, got, missingDefaults)
+		return err
+	}
+	err := fetch(fetchTimeout)
+	for backoff := minBackoff; err != nil || len(changes) > 0; backoff = min(2*backoff, maxBackoff) {
+		// Do not sleep into the deadline, which would leave no time to fetch.
+		if time.Until(deadline) <= backoff {
+			break
+		}
+		time.Sleep(backoff)
+		ferr := fetch(min(fetchTimeout, time.Until(deadline)))
+		if ferr != nil && time.Now().After(deadline) {
+			break
+		}
+		err = ferr
+	}
+	return changes, err
+}
+
+// lookup returns the value of the query, or an empty struct of the type of
+// want if it has none.
+func lookup[T ygot.ValidatedGoStruct](client *ygnmi.Client, query ygnmi.SingletonQuery[T], want T, timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	v, err := ygnmi.Lookup(ctx, client, query)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	got, ok := v.Val()
+	if !ok {
+		got = reflect.New(reflect.TypeOf(want).Elem()).Interface().(T)
+	}
+	return got, nil
+}
+
+// StateConverges calls AwaitState, and reports a test error with a diff of
+// every leaf that did not converge by the timeout.
+func StateConverges[T ygot.ValidatedGoStruct](t testing.TB, dut *ondatra.DUTDevice, subtree Subtree[T], want T, timeout time.Duration) {
+	t.Helper()
+	changes, err := AwaitState(t, dut, subtree, want, timeout)
+	if err != nil {
+		t.Errorf("Failed to fetch the state: %v", err)
+	}
+	if len(changes) > 0 {
+		t.Errorf("State did not converge within %v:\n%s", timeout, FormatChanges(changes))
+	}
+}
+
+// DiffState returns the leaves set in want that are missing from got or have
+// other values in got, sorted by path.  Extra fields in got are ignored.  If
+// missingDefaults is set, leaves missing from got are accepted if want sets
+// them to their default values, as devices with the
+// missing_value_for_defaults deviation omit them.
+func DiffState(want, got ygot.ValidatedGoStruct, missingDefaults bool) ([]*Change, error) {
+	diff, err := ygot.Diff(want, got, &ygot.IgnoreAdditions{})
+	if err != nil {
+		return nil, fmt.Errorf("ygot.Diff failure: %v", err)
+	}
+	changes, err := ExtractChanges(diff, want, got)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare states: %v", err)
+	}
+	if missingDefaults {
+		schema, err := getSchema(want)
+		if err != nil {
+			return nil, fmt.Errorf("schema lookup failure: %v", err)
+		}
+		var kept []*Change
+		for _, c := range changes {
+			if !c.Missing || !isDefault(schema, want, c.Path) {
+				kept = append(kept, c)
+			}
+		}
+		changes = kept
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return PathLabel(changes[i].Path) < PathLabel(changes[j].Path)
+	})
+	return changes, nil
+}
+
+// isDefault returns whether the leaf at the path of root is set to its
+// default value.
+func isDefault(schema *yang.Entry, root ygot.ValidatedGoStruct, pth *gnmipb.Path) bool {
+	nodes, err := ytypes.GetNode(schema, root, pth)
+	if err != nil || len(nodes) != 1 || nodes[0].Schema == nil {
+		return false
+	}
+	tv, err := ygot.EncodeTypedValue(nodes[0].Data, gnmipb.Encoding_JSON)
+	if err != nil || tv == nil {
+		return false
+	}
+	val, ok := scalarString(tv)
+	if !ok {
+		return false
+	}
+	for _, d := range nodes[0].Schema.DefaultValues() {
+		// Identity defaults may be prefixed with their module.
+		if _, name, ok := strings.Cut(d, ":"); ok {
+			d = name
+		}
+		if d == val {
+			return true
+		}
+	}
+	return false
+}
+
+// scalarString returns the scalar value as it would be written in YANG.
+func scalarString(tv *gnmipb.TypedValue) (string, bool) {
+	switch v := tv.GetValue().(type) {
+	case *gnmipb.TypedValue_StringVal:
+		return v.StringVal, true
+	case *gnmipb.TypedValue_UintVal:
+		return strconv.FormatUint(v.UintVal, 10), true
+	case *gnmipb.TypedValue_IntVal:
+		return strconv.FormatInt(v.IntVal, 10), true
+	case *gnmipb.TypedValue_BoolVal:
+		return strconv.FormatBool(v.BoolVal), true
+	case *gnmipb.TypedValue_DoubleVal:
+		return strconv.FormatFloat(v.DoubleVal, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// FormatChanges formats the changes as a leaf-level diff, with a line for
+// each leaf.
+func FormatChanges(changes []*Change) string {
+	var b strings.Builder
+	for _, c := range changes {
+		if c.Missing {
+			fmt.Fprintf(&b, "  %v: missing, want %v\n", PathLabel(c.Path), Readable(c.Want))
+			continue
+		}
+		fmt.Fprintf(&b, "  %v: got %v, want %v\n", PathLabel(c.Path), Readable(c.Got), Readable(c.Want))
+	}
+	return b.String()
+}
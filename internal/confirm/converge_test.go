@@ -0,0 +1,149 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confirm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakebind"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestDiffState(t *testing.T) {
+	want := &oc.Interface{
+		Name:        ygot.String("port1"),
+		Description: ygot.String("uplink"),
+		Enabled:     ygot.Bool(true),
+		Mtu:         ygot.Uint16(9000),
+	}
+	tests := []struct {
+		desc            string
+		want            *oc.Interface
+		got             *oc.Interface
+		missingDefaults bool
+		wantChanges     string
+	}{{
+		desc: "converged",
+		want: want,
+		got: &oc.Interface{
+			Name:        ygot.String("port1"),
+			Description: ygot.String("uplink"),
+			Enabled:     ygot.Bool(true),
+			Mtu:         ygot.Uint16(9000),
+			Type:        oc.IETFInterfaces_InterfaceType_ethernetCsmacd,
+		},
+	}, {
+		desc: "mismatches",
+		want: want,
+		got:  &oc.Interface{Name: ygot.String("port1"), Mtu: ygot.Uint16(1500)},
+		wantChanges: `  /state/description: missing, want &uplink
+  /state/enabled: missing, want &true
+  /state/mtu: got &1500, want &9000
+`,
+	}, {
+		desc:            "missing defaults",
+		want:            want,
+		got:             &oc.Interface{Name: ygot.String("port1"), Mtu: ygot.Uint16(1500)},
+		missingDefaults: true,
+		wantChanges: `  /state/description: missing, want &uplink
+  /state/mtu: got &1500, want &9000
+`,
+	}, {
+		desc:            "missing non-default",
+		want:            &oc.Interface{Name: ygot.String("port1"), Enabled: ygot.Bool(false)},
+		got:             &oc.Interface{Name: ygot.String("port1")},
+		missingDefaults: true,
+		wantChanges: `  /state/enabled: missing, want &false
+`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			changes, err := DiffState(tt.want, tt.got, tt.missingDefaults)
+			if err != nil {
+				t.Fatalf("DiffState() got error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantChanges, FormatChanges(changes)); diff != "" {
+				t.Errorf("DiffState() got unexpected changes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAwaitState(t *testing.T) {
+	dut, err := fakebind.NewDUT(&binding.Dims{
+		Name:  "dut",
+		Ports: map[string]*binding.Port{"port1": {Name: "Ethernet1"}},
+	})
+	if err != nil {
+		t.Fatalf("NewDUT() got error: %v", err)
+	}
+	defer dut.Close()
+	gnmic, err := dut.DialGNMI(context.Background())
+	if err != nil {
+		t.Fatalf("DialGNMI() got error: %v", err)
+	}
+	client, err := ygnmi.NewClient(gnmic, ygnmi.WithTarget("dut"))
+	if err != nil {
+		t.Fatalf("NewClient() got error: %v", err)
+	}
+	query := gnmi.OC().Interface("Ethernet1").State()
+
+	tests := []struct {
+		desc        string
+		want        *oc.Interface
+		timeout     time.Duration
+		wantChanges string
+	}{{
+		desc:    "converged",
+		want:    &oc.Interface{Name: ygot.String("Ethernet1"), Type: oc.IETFInterfaces_InterfaceType_ethernetCsmacd},
+		timeout: time.Second,
+	}, {
+		desc:    "zero timeout",
+		want:    &oc.Interface{Name: ygot.String("Ethernet1"), Mtu: ygot.Uint16(9000)},
+		timeout: 0,
+		wantChanges: `  /state/mtu: missing, want &9000
+`,
+	}, {
+		desc:    "expired timeout",
+		want:    &oc.Interface{Name: ygot.String("Ethernet1"), Mtu: ygot.Uint16(9000)},
+		timeout: -time.Second,
+		wantChanges: `  /state/mtu: missing, want &9000
+`,
+	}, {
+		desc:    "timeout",
+		want:    &oc.Interface{Name: ygot.String("Ethernet1"), Mtu: ygot.Uint16(9000)},
+		timeout: 2 * minBackoff,
+		wantChanges: `  /state/mtu: missing, want &9000
+`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			changes, err := awaitState(client, query, tt.want, false, tt.timeout)
+			if err != nil {
+				t.Fatalf("awaitState() got error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantChanges, FormatChanges(changes)); diff != "" {
+				t.Errorf("awaitState() got unexpected changes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
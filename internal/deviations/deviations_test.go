@@ -0,0 +1,152 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"testing"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestMatchPlatformExceptions(t *testing.T) {
+	arista := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA},
+	}
+	aristaDUT2 := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, DeviceId: "dut2"},
+	}
+	aristaModel := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, HardwareModelRegex: "^cEOS"},
+	}
+	aristaVersion := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, SoftwareVersionRegex: "^4\\.30"},
+	}
+	aristaRange := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, SoftwareVersionRange: &mpb.Metadata_VersionRange{MaxExclusive: "4.31.0F"}},
+	}
+	aristaFixedRange := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, SoftwareVersionRange: &mpb.Metadata_VersionRange{MaxExclusive: "4.30.1F"}},
+	}
+	aristaBadRange := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, SoftwareVersionRange: &mpb.Metadata_VersionRange{MaxExclusive: "latest"}},
+	}
+	cisco := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_CISCO},
+	}
+
+	cases := []struct {
+		desc    string
+		pes     []*mpb.Metadata_PlatformExceptions
+		id      string
+		vendor  string
+		want    *mpb.Metadata_PlatformExceptions
+		wantErr bool
+	}{{
+		desc:   "no match",
+		pes:    []*mpb.Metadata_PlatformExceptions{cisco},
+		id:     "dut",
+		vendor: "ARISTA",
+	}, {
+		desc:   "vendor match",
+		pes:    []*mpb.Metadata_PlatformExceptions{cisco, arista},
+		id:     "dut",
+		vendor: "ARISTA",
+		want:   arista,
+	}, {
+		desc:   "device id takes precedence",
+		pes:    []*mpb.Metadata_PlatformExceptions{arista, aristaModel, aristaDUT2},
+		id:     "dut2",
+		vendor: "ARISTA",
+		want:   aristaDUT2,
+	}, {
+		desc:   "device id does not match other device",
+		pes:    []*mpb.Metadata_PlatformExceptions{arista, aristaDUT2},
+		id:     "dut1",
+		vendor: "ARISTA",
+		want:   arista,
+	}, {
+		desc:   "model takes precedence over version",
+		pes:    []*mpb.Metadata_PlatformExceptions{aristaVersion, aristaModel, arista},
+		id:     "dut",
+		vendor: "ARISTA",
+		want:   aristaModel,
+	}, {
+		desc:   "version range takes precedence over vendor",
+		pes:    []*mpb.Metadata_PlatformExceptions{arista, aristaRange},
+		id:     "dut",
+		vendor: "ARISTA",
+		want:   aristaRange,
+	}, {
+		desc:   "version range does not match fixed version",
+		pes:    []*mpb.Metadata_PlatformExceptions{arista, aristaFixedRange},
+		id:     "dut",
+		vendor: "ARISTA",
+		want:   arista,
+	}, {
+		desc:    "version regex and range equally specific",
+		pes:     []*mpb.Metadata_PlatformExceptions{aristaVersion, aristaRange},
+		id:      "dut",
+		vendor:  "ARISTA",
+		wantErr: true,
+	}, {
+		desc:    "bad version range",
+		pes:     []*mpb.Metadata_PlatformExceptions{aristaBadRange},
+		id:      "dut",
+		vendor:  "ARISTA",
+		wantErr: true,
+	}, {
+		desc:    "equally specific",
+		pes:     []*mpb.Metadata_PlatformExceptions{arista, arista},
+		id:      "dut",
+		vendor:  "ARISTA",
+		wantErr: true,
+	}, {
+		desc:   "equally specific but superseded",
+		pes:    []*mpb.Metadata_PlatformExceptions{arista, arista, aristaDUT2},
+		id:     "dut2",
+		vendor: "ARISTA",
+		want:   aristaDUT2,
+	}}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			got, err := matchPlatformExceptions(c.pes, c.id, c.vendor, "cEOS", "4.30.1F")
+			if (err != nil) != c.wantErr {
+				t.Fatalf("matchPlatformExceptions() got error %v, want error %v", err, c.wantErr)
+			}
+			if got != c.want {
+				t.Errorf("matchPlatformExceptions() got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestMatchPlatformExceptionsUnparsedVersion(t *testing.T) {
+	arista := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA},
+	}
+	aristaRange := &mpb.Metadata_PlatformExceptions{
+		Platform: &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, SoftwareVersionRange: &mpb.Metadata_VersionRange{MaxExclusive: "4.31.0F"}},
+	}
+	for _, version := range []string{"", "unknown"} {
+		got, err := matchPlatformExceptions([]*mpb.Metadata_PlatformExceptions{arista, aristaRange}, "dut", "ARISTA", "cEOS", version)
+		if err != nil {
+			t.Fatalf("matchPlatformExceptions(%q) got error: %v", version, err)
+		}
+		if got != arista {
+			t.Errorf("matchPlatformExceptions(%q) got %v, want %v", version, got, arista)
+		}
+	}
+}
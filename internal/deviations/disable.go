@@ -0,0 +1,86 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	log "github.com/golang/glog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+// DisableFlag is the name of the flag that forces deviations to their default value.
+const DisableFlag = "deviations_disable"
+
+// DisableAll is the value of DisableFlag that disables all deviations.
+const DisableAll = "all"
+
+var disable = flag.String(DisableFlag, "", `Comma separated list of deviations, by their field name in metadata.proto, that are forced to their default value regardless of metadata.textproto, or "all" to disable every deviation.  Use this to check whether a test passes without the deviations.  Deviations set by their own command line flag are not affected.`)
+
+// parseDisable returns the deviation fields named by the comma separated list,
+// or nil if all fields are disabled.
+func parseDisable(list string) (map[protoreflect.Name]bool, error) {
+	if list == DisableAll {
+		return nil, nil
+	}
+	fds := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor().Fields()
+	names := make(map[protoreflect.Name]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if fds.ByName(protoreflect.Name(name)) == nil {
+			return nil, fmt.Errorf("unknown deviation %q in -%s", name, DisableFlag)
+		}
+		names[protoreflect.Name(name)] = true
+	}
+	return names, nil
+}
+
+// applyDisable returns the deviations with the fields named by list cleared.
+// The input is not modified.
+func applyDisable(d *mpb.Metadata_Deviations, list string) (*mpb.Metadata_Deviations, error) {
+	if list == "" || d == nil {
+		return d, nil
+	}
+	names, err := parseDisable(list)
+	if err != nil {
+		return nil, err
+	}
+	if names == nil {
+		return &mpb.Metadata_Deviations{}, nil
+	}
+	d = proto.Clone(d).(*mpb.Metadata_Deviations)
+	m := d.ProtoReflect()
+	for name := range names {
+		m.Clear(m.Descriptor().Fields().ByName(name))
+	}
+	return d, nil
+}
+
+// withDisabled applies the -deviations_disable flag to the deviations.
+func withDisabled(d *mpb.Metadata_Deviations) *mpb.Metadata_Deviations {
+	d, err := applyDisable(d, *disable)
+	if err != nil {
+		log.Exitf("Error disabling deviations: %v", err)
+	}
+	return d
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"flag"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+// The accessors and the "deviation_<field>" flags for the fields of the
+// Deviations message are generated from proto/metadata.proto.  Run go generate
+// after changing the message.
+//go:generate go run ./gen -proto ../../proto/metadata.proto -out deviations_generated.go
+
+// visitFlags visits the flags set on the command line; tests replace it so
+// as not to set the flags of the command line.
+var visitFlags = flag.Visit
+
+// withFlags returns the deviations with the fields whose deviation flag is set
+// on the command line replaced by the flag value.  The input is not modified.
+func withFlags(d *mpb.Metadata_Deviations) *mpb.Metadata_Deviations {
+	var set []string
+	visitFlags(func(f *flag.Flag) {
+		if strings.HasPrefix(f.Name, "deviation_") {
+			set = append(set, f.Name)
+		}
+	})
+	if len(set) == 0 {
+		return d
+	}
+	if d == nil {
+		d = &mpb.Metadata_Deviations{}
+	} else {
+		d = proto.Clone(d).(*mpb.Metadata_Deviations)
+	}
+	for _, name := range set {
+		setFromFlag(d, name)
+	}
+	return d
+}
+
+// int32Value is the value of an int32 deviation flag.  Unlike flag.Int, it
+// rejects the values out of range of the field instead of truncating them.
+type int32Value int32
+
+func (v *int32Value) String() string { return strconv.FormatInt(int64(*v), 10) }
+
+func (v *int32Value) Set(s string) error {
+	n, err := strconv.ParseInt(s, 0, 32)
+	if err != nil {
+		return err
+	}
+	*v = int32Value(n)
+	return nil
+}
+
+// int32Flag defines an int32 flag with the name, default value and usage.
+func int32Flag(name string, value int32, usage string) *int32 {
+	p := &value
+	flag.Var((*int32Value)(p), name, usage)
+	return p
+}
+
+// uint32Value is the value of a uint32 deviation flag.  Unlike flag.Uint, it
+// rejects the values out of range of the field instead of truncating them.
+type uint32Value uint32
+
+func (v *uint32Value) String() string { return strconv.FormatUint(uint64(*v), 10) }
+
+func (v *uint32Value) Set(s string) error {
+	n, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return err
+	}
+	*v = uint32Value(n)
+	return nil
+}
+
+// uint32Flag defines a uint32 flag with the name, default value and usage.
+func uint32Flag(name string, value uint32, usage string) *uint32 {
+	p := &value
+	flag.Var((*uint32Value)(p), name, usage)
+	return p
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+func TestWithFlags(t *testing.T) {
+	in := &mpb.Metadata_Deviations{OmitL2Mtu: true, BannerDelimiter: "^C"}
+	if got := withFlags(in); got != in {
+		t.Errorf("withFlags() without flags got %v, want the input unchanged", got)
+	}
+
+	// Set the flag values without marking the flags as set on the command
+	// line, and restore them after the test.
+	var set []*flag.Flag
+	for name, value := range map[string]string{
+		"deviation_omit_l2_mtu":              "false",
+		"deviation_bgp_tolerance_value":      "5",
+		"deviation_default_network_instance": "default",
+	} {
+		f := flag.Lookup(name)
+		if f == nil {
+			t.Fatalf("flag.Lookup(%q) got nil", name)
+		}
+		old := f.Value.String()
+		t.Cleanup(func() { f.Value.Set(old) })
+		if err := f.Value.Set(value); err != nil {
+			t.Fatalf("Set(%q) of flag %q got error: %v", value, name, err)
+		}
+		set = append(set, f)
+	}
+	defer func(visit func(func(*flag.Flag))) { visitFlags = visit }(visitFlags)
+	visitFlags = func(fn func(*flag.Flag)) {
+		for _, f := range set {
+			fn(f)
+		}
+	}
+	want := &mpb.Metadata_Deviations{BannerDelimiter: "^C", BgpToleranceValue: 5, DefaultNetworkInstance: "default"}
+	if diff := cmp.Diff(want, withFlags(in), protocmp.Transform()); diff != "" {
+		t.Errorf("withFlags() -want,+got:\n%s", diff)
+	}
+	if !in.GetOmitL2Mtu() {
+		t.Errorf("withFlags() modified its input")
+	}
+	if diff := cmp.Diff(want, withFlags(nil), protocmp.Transform(), protocmp.IgnoreFields(want, "banner_delimiter")); diff != "" {
+		t.Errorf("withFlags(nil) -want,+got:\n%s", diff)
+	}
+}
+
+func TestIntFlagRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"deviation_bgp_tolerance_value", "-2147483648", false},
+		{"deviation_bgp_tolerance_value", "2147483647", false},
+		{"deviation_bgp_tolerance_value", "2147483648", true},
+		{"deviation_bgp_tolerance_value", "-2147483649", true},
+		{"deviation_plq_generator_capabilities_max_mtu", "4294967295", false},
+		{"deviation_plq_generator_capabilities_max_mtu", "4294967296", true},
+		{"deviation_plq_generator_capabilities_max_mtu", "-1", true},
+	}
+	for _, test := range tests {
+		f := flag.Lookup(test.name)
+		if f == nil {
+			t.Fatalf("flag.Lookup(%q) got nil", test.name)
+		}
+		old := f.Value.String()
+		err := f.Value.Set(test.value)
+		if gotErr := err != nil; gotErr != test.wantErr {
+			t.Errorf("Set(%q) of flag %q got error %v, want error %v", test.value, test.name, err, test.wantErr)
+		}
+		if err == nil && f.Value.String() != test.value {
+			t.Errorf("Set(%q) of flag %q got value %q, want %q", test.value, test.name, f.Value.String(), test.value)
+		}
+		if err := f.Value.Set(old); err != nil {
+			t.Fatalf("Set(%q) of flag %q got error: %v", old, test.name, err)
+		}
+	}
+}
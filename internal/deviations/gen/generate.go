@@ -0,0 +1,268 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main generates the deviation accessors and flags from the Deviations
+// message in proto/metadata.proto.
+//
+// For every field of the message, it emits a "deviation_<field>" flag that
+// overrides the value from metadata.textproto when set, and an accessor whose
+// doc comment is taken from the comment of the field in metadata.proto.  It is
+// run by go generate in the internal/deviations directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	log "github.com/golang/glog"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+var (
+	protoFile = flag.String("proto", "../../proto/metadata.proto", "Path to metadata.proto, which is read for the comments of the Deviations fields.")
+	outFile   = flag.String("out", "deviations_generated.go", "Path of the generated Go source file.")
+)
+
+// field describes a Deviations field for the template.
+type field struct {
+	Name      string                   // Proto field name, e.g. "omit_l2_mtu".
+	Number    protoreflect.FieldNumber // Proto field number.
+	GoName    string                   // Go field name, e.g. "OmitL2Mtu".
+	Accessor  string                   // Accessor name, e.g. "OmitL2MTU".
+	ATE       bool                     // Whether the accessor takes an ATE.
+	Generated bool                     // Whether the accessor is generated.
+	Comment   []string                 // Lines of the proto comment.
+	GoType    string                   // Go type of the field, e.g. "bool".
+	FlagFunc  string                   // Function defining the flag, e.g. "flag.Bool".
+}
+
+// Var returns the name of the flag variable.
+func (f *field) Var() string {
+	return "flag" + f.GoName
+}
+
+// Usage returns the usage text of the flag.
+func (f *field) Usage() string {
+	if len(f.Comment) == 0 {
+		return fmt.Sprintf("Overrides the %s deviation.", f.Name)
+	}
+	return strings.Join(f.Comment, " ")
+}
+
+// kinds maps the proto kinds used by the Deviations message to the Go type of
+// the field and the function defining a flag of that type.  The 32-bit flags
+// are defined in the deviations package, as the flag package has none.
+var kinds = map[protoreflect.Kind][2]string{
+	protoreflect.BoolKind:   {"bool", "flag.Bool"},
+	protoreflect.StringKind: {"string", "flag.String"},
+	protoreflect.Int32Kind:  {"int32", "int32Flag"},
+	protoreflect.Uint32Kind: {"uint32", "uint32Flag"},
+	protoreflect.Uint64Kind: {"uint64", "flag.Uint64"},
+	protoreflect.DoubleKind: {"float64", "flag.Float64"},
+}
+
+var (
+	messageRE = regexp.MustCompile(`^\s*message\s+Deviations\s*{`)
+	fieldRE   = regexp.MustCompile(`^\s*\w+\s+(\w+)\s*=\s*\d+\s*;`)
+)
+
+// readComments returns the comment lines preceding each field of the Deviations
+// message in the proto source, keyed by field name.
+func readComments(r io.Reader) (map[string][]string, error) {
+	comments := make(map[string][]string)
+	sc := bufio.NewScanner(r)
+	inMessage := false
+	depth := 0
+	var pending []string
+	for sc.Scan() {
+		line := sc.Text()
+		if !inMessage {
+			if messageRE.MatchString(line) {
+				inMessage = true
+				depth = 1
+			}
+			continue
+		}
+		if text, ok := strings.CutPrefix(strings.TrimSpace(line), "//"); ok {
+			pending = append(pending, strings.TrimSpace(text))
+			continue
+		}
+		depth += strings.Count(line, "{") - strings.Count(line, "}")
+		if depth <= 0 {
+			break
+		}
+		if m := fieldRE.FindStringSubmatch(line); m != nil {
+			comments[m[1]] = pending
+		}
+		pending = nil
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if !inMessage {
+		return nil, fmt.Errorf("message Deviations not found")
+	}
+	return comments, nil
+}
+
+// goNames maps the proto field names of the Deviations message to the Go field
+// names, e.g. "omit_l2_mtu" to "OmitL2Mtu".
+func goNames() map[string]string {
+	m := make(map[string]string)
+	rt := reflect.TypeOf(mpb.Metadata_Deviations{})
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag, ok := sf.Tag.Lookup("protobuf")
+		if !ok {
+			continue
+		}
+		for _, part := range strings.Split(tag, ",") {
+			if name, ok := strings.CutPrefix(part, "name="); ok {
+				m[name] = sf.Name
+			}
+		}
+	}
+	return m
+}
+
+// fields returns the Deviations fields in field number order.
+func fields(comments map[string][]string) ([]*field, error) {
+	names := goNames()
+	fds := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor().Fields()
+	var fs []*field
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		name := string(fd.Name())
+		k, ok := kinds[fd.Kind()]
+		if !ok || fd.Cardinality() == protoreflect.Repeated || fd.HasPresence() {
+			return nil, fmt.Errorf("field %s has unsupported type %v %v", name, fd.Cardinality(), fd.Kind())
+		}
+		f := &field{
+			Name:      name,
+			Number:    fd.Number(),
+			GoName:    names[name],
+			Accessor:  names[name],
+			ATE:       ateFields[name],
+			Generated: !handWritten[name],
+			Comment:   comments[name],
+			GoType:    k[0],
+			FlagFunc:  k[1],
+		}
+		if a, ok := accessorNames[name]; ok {
+			f.Accessor = a
+		}
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].Number < fs[j].Number })
+	return fs, nil
+}
+
+var tmpl = template.Must(template.New("deviations").Funcs(template.FuncMap{
+	"quote": strconv.Quote,
+}).Parse(`// Code generated by internal/deviations/gen from proto/metadata.proto. DO NOT EDIT.
+
+package deviations
+
+import (
+	"flag"
+
+	"github.com/openconfig/ondatra"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+// Deviation flags, one for each field of the Deviations message.  If a flag is
+// set, its value takes precedence over the metadata value.
+var (
+{{- range .}}
+	{{.Var}} = {{.FlagFunc}}({{printf "deviation_%s" .Name | quote}}, {{if eq .GoType "string"}}""{{else if eq .GoType "bool"}}false{{else}}0{{end}}, {{quote .Usage}})
+{{- end}}
+)
+
+// setFromFlag sets the field of d overridden by the named deviation flag to the
+// flag value.
+func setFromFlag(d *mpb.Metadata_Deviations, flagName string) {
+	switch flagName {
+{{- range .}}
+	case {{printf "deviation_%s" .Name | quote}}:
+		d.{{.GoName}} = *{{.Var}}
+{{- end}}
+	}
+}
+{{range .}}{{if .Generated}}
+// {{.Accessor}} returns the value of the {{.Name}} deviation.
+{{- range .Comment}}
+//{{if .}} {{.}}{{end}}
+{{- end}}
+{{- if .ATE}}
+func {{.Accessor}}(ate *ondatra.ATEDevice) {{.GoType}} {
+	return lookupATEDeviations(ate).Get{{.GoName}}()
+}
+{{- else}}
+func {{.Accessor}}(dut *ondatra.DUTDevice) {{.GoType}} {
+	return lookupDUTDeviations(dut).Get{{.GoName}}()
+}
+{{- end}}
+{{end}}{{end}}`))
+
+// generate writes the Go source of the accessors and flags to w.
+func generate(w io.Writer, proto io.Reader) error {
+	comments, err := readComments(proto)
+	if err != nil {
+		return err
+	}
+	fs, err := fields(comments)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, fs); err != nil {
+		return err
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not format generated source: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	flag.Parse()
+	in, err := os.Open(*protoFile)
+	if err != nil {
+		log.Exitf("Could not open proto: %v", err)
+	}
+	defer in.Close()
+	var b bytes.Buffer
+	if err := generate(&b, in); err != nil {
+		log.Exitf("Could not generate deviations from %s: %v", *protoFile, err)
+	}
+	if err := os.WriteFile(*outFile, b.Bytes(), 0644); err != nil {
+		log.Exitf("Could not write %s: %v", *outFile, err)
+	}
+}
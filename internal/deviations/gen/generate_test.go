@@ -0,0 +1,103 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadComments(t *testing.T) {
+	const src = `
+message Metadata {
+  // Not a deviation.
+  string uuid = 1;
+  message Deviations {
+    // Device does not support interface/ipv4/enabled,
+    // so suppress configuring this leaf.
+    bool ipv4_missing_enabled = 1;
+
+    bool traceroute_fragmentation = 2;
+    // Description of the reserved fields, not of the next field.
+    reserved 3;
+    // Nested braces {} in a comment.
+    //
+    // Second paragraph.
+    string banner_delimiter = 4;
+  }
+  // Not a deviation either.
+  string plan_id = 2;
+}
+`
+	got, err := readComments(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("readComments() got error: %v", err)
+	}
+	want := map[string][]string{
+		"ipv4_missing_enabled":     {"Device does not support interface/ipv4/enabled,", "so suppress configuring this leaf."},
+		"traceroute_fragmentation": nil,
+		"banner_delimiter":         {"Nested braces {} in a comment.", "", "Second paragraph."},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("readComments() -want,+got:\n%s", diff)
+	}
+}
+
+func TestReadCommentsNoMessage(t *testing.T) {
+	if _, err := readComments(strings.NewReader("message Metadata {}\n")); err == nil {
+		t.Errorf("readComments() got no error, want error")
+	}
+}
+
+// TestGenerated fails if deviations_generated.go is not up to date with
+// metadata.proto.
+func TestGenerated(t *testing.T) {
+	in, err := os.Open("../../../proto/metadata.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer in.Close()
+	var got bytes.Buffer
+	if err := generate(&got, in); err != nil {
+		t.Fatalf("generate() got error: %v", err)
+	}
+	want, err := os.ReadFile("../deviations_generated.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(want), got.String()); diff != "" {
+		t.Errorf("deviations_generated.go is stale, run go generate in internal/deviations. -want,+got:\n%s", diff)
+	}
+}
+
+func TestAccessorTables(t *testing.T) {
+	names := goNames()
+	for _, table := range []map[string]bool{ateFields, handWritten} {
+		for name := range table {
+			if _, ok := names[name]; !ok {
+				t.Errorf("Unknown Deviations field %q", name)
+			}
+		}
+	}
+	for name := range accessorNames {
+		if _, ok := names[name]; !ok {
+			t.Errorf("accessorNames has unknown Deviations field %q", name)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+// accessorNames maps the Deviations fields to the name of their accessor where
+// it differs from the Go name of the field.  These names predate the generator
+// and are kept so that the tests calling them need not change.  New deviations
+// should not be added here.
+var accessorNames = map[string]string{
+	"ipv4_missing_enabled":                                     "IPv4MissingEnabled",
+	"traceroute_l4_protocol_udp":                               "TraceRouteL4ProtocolUDP",
+	"prepolicy_received_routes":                                "MissingPrePolicyReceivedRoutes",
+	"isis_multi_topology_unsupported":                          "ISISMultiTopologyUnsupported",
+	"isis_interface_level1_disable_required":                   "ISISInterfaceLevel1DisableRequired",
+	"isis_single_topology_required":                            "ISISSingleTopologyRequired",
+	"isis_instance_enabled_required":                           "ISISInstanceEnabledRequired",
+	"isis_global_authentication_not_required":                  "ISISGlobalAuthenticationNotRequired",
+	"isis_explicit_level_authentication_config":                "ISISExplicitLevelAuthenticationConfig",
+	"isis_restart_suppress_unsupported":                        "ISISRestartSuppressUnsupported",
+	"ip_neighbor_missing":                                      "IPNeighborMissing",
+	"osactivate_noreboot":                                      "OSActivateNoReboot",
+	"osinstall_for_standby_rp":                                 "InstallOSForStandbyRP",
+	"lldp_interface_config_override_global":                    "LLDPInterfaceConfigOverrideGlobal",
+	"state_path_unsupported":                                   "StatePathsUnsupported",
+	"ipv6_enable_for_gribi_nh_dmac":                            "ExplicitIPv6EnableForGRIBI",
+	"ecn_profile_required_definition":                          "ECNProfileRequiredDefinition",
+	"cli_takes_precedence_over_oc":                             "CLITakesPrecedenceOverOC",
+	"switch_chip_id_unsupported":                               "SwitchChipIDUnsupported",
+	"explicit_interface_in_default_vrf":                        "ExplicitInterfaceInDefaultVRF",
+	"gribi_mac_override_with_static_arp":                       "GRIBIMACOverrideWithStaticARP",
+	"route_policy_under_afi_unsupported":                       "RoutePolicyUnderAFIUnsupported",
+	"gnoi_fabric_component_reboot_unsupported":                 "GNOIFabricComponentRebootUnsupported",
+	"omit_l2_mtu":                                              "OmitL2MTU",
+	"bgp_tolerance_value":                                      "BGPTrafficTolerance",
+	"gnoi_status_empty_subcomponent":                           "GNOIStatusWithEmptySubcomponent",
+	"bgp_md5_requires_reset":                                   "BGPMD5RequiresReset",
+	"gribi_riback_only":                                        "GRIBIRIBAckOnly",
+	"gnoi_subcomponent_path":                                   "GNOISubcomponentPath",
+	"interface_config_vrf_before_address":                      "InterfaceConfigVRFBeforeAddress",
+	"deprecated_vlan_id":                                       "DeprecatedVlanID",
+	"gribi_mac_override_static_arp_static_route":               "GRIBIMACOverrideStaticARPStaticRoute",
+	"qos_octets":                                               "QOSOctets",
+	"cpu_missing_ancestor":                                     "CPUMissingAncestor",
+	"require_routed_subinterface_0":                            "RequireRoutedSubinterface0",
+	"gnoi_switchover_reason_missing_user_initiated":            "GNOISwitchoverReasonMissingUserInitiated",
+	"p4rt_unsetelectionid_primary_allowed":                     "P4rtUnsetElectionIDPrimaryAllowed",
+	"bkup_arbitration_resp_code":                               "P4rtBackupArbitrationResponseCode",
+	"backup_nhg_requires_vrf_with_decap":                       "BackupNHGRequiresVrfWithDecap",
+	"isis_interface_afi_unsupported":                           "ISISInterfaceAfiUnsupported",
+	"p4rt_modify_table_entry_unsupported":                      "P4RTModifyTableEntryUnsupported",
+	"os_component_parent_is_supervisor_or_linecard":            "OSComponentParentIsSupervisorOrLinecard",
+	"os_component_parent_is_chassis":                           "OSComponentParentIsChassis",
+	"isis_require_same_l1_metric_with_l2_metric":               "ISISRequireSameL1MetricWithL2Metric",
+	"bgp_set_med_requires_equal_ospf_set_metric":               "BGPSetMedRequiresEqualOspfSetMetric",
+	"p4rt_gdp_requires_dot1q_subinterface":                     "P4RTGdpRequiresDot1QSubinterface",
+	"ate_port_link_state_operations_unsupported":               "ATEPortLinkStateOperationsUnsupported",
+	"linecard_cpu_utilization_unsupported":                     "LinecardCPUUtilizationUnsupported",
+	"controller_card_cpu_utilization_unsupported":              "ControllerCardCPUUtilizationUnsupported",
+	"qos_voq_drop_counter_unsupported":                         "QOSVoqDropCounterUnsupported",
+	"ate_ipv6_flow_label_unsupported":                          "ATEIPv6FlowLabelUnsupported",
+	"isis_timers_csnp_interval_unsupported":                    "ISISTimersCsnpIntervalUnsupported",
+	"isis_counter_manual_address_drop_from_areas_unsupported":  "ISISCounterManualAddressDropFromAreasUnsupported",
+	"isis_counter_part_changes_unsupported":                    "ISISCounterPartChangesUnsupported",
+	"skip_tcp_negotiated_mss_check":                            "SkipTCPNegotiatedMSSCheck",
+	"isis_lsp_metadata_leafs_unsupported":                      "ISISLspMetadataLeafsUnsupported",
+	"qos_queue_requires_id":                                    "QOSQueueRequiresID",
+	"qos_buffer_allocation_config_required":                    "QOSBufferAllocationConfigRequired",
+	"bgp_global_extended_next_hop_encoding_unsupported":        "BGPGlobalExtendedNextHopEncodingUnsupported",
+	"isis_level_enabled":                                       "ISISLevelEnabled",
+	"interface_ref_interface_id_format":                        "InterfaceRefInterfaceIDFormat",
+	"bgp_explicit_prefix_limit_received":                       "BGPExplicitPrefixLimitReceived",
+	"bgp_missing_oc_max_prefixes_configuration":                "BGPMissingOCMaxPrefixesConfiguration",
+	"gnoi_subcomponent_reboot_status_unsupported":              "GNOISubcomponentRebootStatusUnsupported",
+	"isis_metric_style_telemetry_unsupported":                  "ISISMetricStyleTelemetryUnsupported",
+	"skip_setting_allow_multiple_as":                           "SkipSettingAllowMultipleAS",
+	"bgp_conditions_match_community_set_unsupported":           "BGPConditionsMatchCommunitySetUnsupported",
+	"missing_port_to_optical_channel_component_mapping":        "MissingPortToOpticalChannelMapping",
+	"bgp_rib_oc_path_unsupported":                              "BGPRibOcPathUnsupported",
+	"ipv6_static_route_with_ipv4_next_hop_requires_static_arp": "IPv6StaticRouteWithIPv4NextHopRequiresStaticARP",
+	"missing_zr_optical_channel_tunable_parameters_telemetry":  "MissingZROpticalChannelTunableParametersTelemetry",
+	"plq_reflector_stats_unsupported":                          "PLQReflectorStatsUnsupported",
+	"plq_generator_capabilities_max_mtu":                       "PLQGeneratorCapabilitiesMaxMTU",
+	"plq_generator_capabilities_max_pps":                       "PLQGeneratorCapabilitiesMaxPPS",
+	"ipv4_static_route_with_ipv6_nh_unsupported":               "IPv4StaticRouteWithIPv6NextHopUnsupported",
+	"ipv6_static_route_with_ipv4_nh_unsupported":               "IPv6StaticRouteWithIPv4NextHopUnsupported",
+	"static_route_with_drop_nh":                                "StaticRouteWithDropNhUnsupported",
+	"explicit_enable_bgp_on_default_vrf":                       "ExplicitEnableBGPOnDefaultVRF",
+	"isis_loopback_required":                                   "ISISLoopbackRequired",
+	"qos_inqueue_drop_counter_unsupported":                     "QOSInQueueDropCounterUnsupported",
+	"decap_nh_with_nexthop_ni_unsupported":                     "DecapNHWithNextHopNIUnsupported",
+	"otn_channel_trib_unsupported":                             "OTNChannelTribUnsupported",
+	"chassis_get_rpc_unsupported":                              "ChassisGetRPCUnsupported",
+	"ssh_server_counters_unsupported":                          "SSHServerCountersUnsupported",
+	"otn_channel_assignment_cisco_numbering":                   "OTNChannelAssignmentCiscoNumbering",
+	"cisco_pre_fec_ber_inactive_value":                         "CiscoPreFECBERInactiveValue",
+	"channel_assignment_rate_class_parameters_unsupported":     "ChannelRateClassParametersUnsupported",
+	"qos_scheduler_ingress_policer_unsupported":                "QosSchedulerIngressPolicer",
+	"p4rt_capabilities_unsupported":                            "P4RTCapabilitiesUnsupported",
+	"gnmi_get_on_root_unsupported":                             "GNMIGetOnRootUnsupported",
+	"set_isis_auth_with_interface_authentication_container":    "SetISISAuthWithInterfaceAuthenticationContainer",
+}
+
+// ateFields are the Deviations fields whose accessor takes an ATE rather than a
+// DUT.
+var ateFields = map[string]bool{
+	"ate_port_link_state_operations_unsupported": true,
+	"ate_ipv6_flow_label_unsupported":            true,
+}
+
+// handWritten are the Deviations fields whose accessor is written by hand in
+// deviations.go, usually to supply a default value for an unset field.  These
+// fields still get a flag.
+var handWritten = map[string]bool{
+	"hierarchical_weight_resolution_tolerance": true,
+	"static_protocol_name":                     true,
+	"default_network_instance":                 true,
+	"default_bgp_instance_name":                true,
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"encoding/json"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DeviceUsage describes how the deviations of a single device were used by a test.
+type DeviceUsage struct {
+	Vendor  string `json:"vendor"`
+	Model   string `json:"model"`
+	Version string `json:"version"`
+	// Matched is true if one of the platform_exceptions in metadata.textproto
+	// matched the device.
+	Matched bool `json:"platform_exception_matched"`
+	// Set lists the deviations in effect for the device: those of the
+	// matched platform_exceptions entry that are not disabled by
+	// -deviations_disable, and those set by their command line flag.
+	Set []string `json:"deviations_set"`
+	// Consulted maps the name of each deviation accessor called for the
+	// device to the number of times it was called.
+	Consulted map[string]int `json:"deviations_consulted"`
+}
+
+// usageRecorder keeps track of the deviation accessors called for each device.
+type usageRecorder struct {
+	mu      sync.Mutex
+	devices map[string]*DeviceUsage
+}
+
+var usage = &usageRecorder{devices: map[string]*DeviceUsage{}}
+
+// record notes that the accessor was called for the device with the given ID,
+// whose effective deviations are d.
+func (r *usageRecorder) record(id, vendor, model, version string, matched bool, d *mpb.Metadata_Deviations, accessor string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	du, ok := r.devices[id]
+	if !ok {
+		du = &DeviceUsage{
+			Vendor:    vendor,
+			Model:     model,
+			Version:   version,
+			Matched:   matched,
+			Set:       setDeviations(d),
+			Consulted: map[string]int{},
+		}
+		r.devices[id] = du
+	}
+	du.Consulted[accessor]++
+}
+
+// snapshot returns a deep copy of the recorded usage keyed by device ID.
+func (r *usageRecorder) snapshot() map[string]*DeviceUsage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := make(map[string]*DeviceUsage, len(r.devices))
+	for id, du := range r.devices {
+		c := *du
+		c.Set = append([]string{}, du.Set...)
+		c.Consulted = make(map[string]int, len(du.Consulted))
+		for k, v := range du.Consulted {
+			c.Consulted[k] = v
+		}
+		m[id] = &c
+	}
+	return m
+}
+
+// setDeviations returns the sorted proto field names of the deviations that
+// are populated in d.
+func setDeviations(d *mpb.Metadata_Deviations) []string {
+	set := []string{}
+	if d == nil {
+		return set
+	}
+	d.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		set = append(set, string(fd.Name()))
+		return true
+	})
+	sort.Strings(set)
+	return set
+}
+
+// callerAccessor returns the unqualified name of the function skip frames
+// above the caller of callerAccessor, which is expected to be an exported
+// deviation accessor.
+func callerAccessor(skip int) string {
+	pc := make([]uintptr, skip+2)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for i := 0; ; i++ {
+		frame, more := frames.Next()
+		if i == skip {
+			return frame.Function[strings.LastIndex(frame.Function, ".")+1:]
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// Usage returns the deviation accessors that were called so far, keyed by
+// the ID of the device they were called for (e.g. "dut", "ate").
+func Usage() map[string]*DeviceUsage {
+	return usage.snapshot()
+}
+
+// UsageJSON returns the deviation usage as an indented JSON document suitable
+// for machine consumption.
+func UsageJSON() ([]byte, error) {
+	return json.MarshalIndent(Usage(), "", "  ")
+}
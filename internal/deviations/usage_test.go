@@ -0,0 +1,69 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+func TestUsageRecorder(t *testing.T) {
+	d := &mpb.Metadata_Deviations{
+		OmitL2Mtu:       true,
+		BannerDelimiter: "^C",
+	}
+	r := &usageRecorder{devices: map[string]*DeviceUsage{}}
+	r.record("dut", "ARISTA", "cEOS", "4.30", true, d, "OmitL2MTU")
+	r.record("dut", "ARISTA", "cEOS", "4.30", true, d, "OmitL2MTU")
+	r.record("dut", "ARISTA", "cEOS", "4.30", true, d, "BannerDelimiter")
+	r.record("ate", "OPENCONFIG", "", "", false, &mpb.Metadata_Deviations{}, "ATEIPv6FlowLabelUnsupported")
+	// Deviations set by a flag are in effect without a platform exception.
+	r.record("dut2", "NOKIA", "SR Linux", "", false, &mpb.Metadata_Deviations{OmitL2Mtu: true}, "OmitL2MTU")
+
+	want := map[string]*DeviceUsage{
+		"dut": {
+			Vendor:    "ARISTA",
+			Model:     "cEOS",
+			Version:   "4.30",
+			Matched:   true,
+			Set:       []string{"banner_delimiter", "omit_l2_mtu"},
+			Consulted: map[string]int{"OmitL2MTU": 2, "BannerDelimiter": 1},
+		},
+		"ate": {
+			Vendor:    "OPENCONFIG",
+			Set:       []string{},
+			Consulted: map[string]int{"ATEIPv6FlowLabelUnsupported": 1},
+		},
+		"dut2": {
+			Vendor:    "NOKIA",
+			Model:     "SR Linux",
+			Set:       []string{"omit_l2_mtu"},
+			Consulted: map[string]int{"OmitL2MTU": 1},
+		},
+	}
+	if diff := cmp.Diff(want, r.snapshot()); diff != "" {
+		t.Errorf("snapshot() got unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestCallerAccessor(t *testing.T) {
+	// callerAccessor(1) from inner reports the function calling inner.
+	inner := func() string { return callerAccessor(1) }
+	if got, want := inner(), "TestCallerAccessor"; got != want {
+		t.Errorf("callerAccessor(1) got %q, want %q", got, want)
+	}
+}
@@ -38,10 +38,47 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 	config := gnmi.Get[*oc.Root](t, dev, gnmi.OC().Config())
 	WriteQuery(t, "Untouched", gnmi.OC().Config(), config)
 
+	dut := ondatra.DUT(t, "dut")
+	err := sanitizeConfig(config, &configDevice{
+		vendor:              dut.Vendor(),
+		skipMacaddressCheck: func() bool { return deviations.SkipMacaddressCheck(dut) },
+		interfaces: func() ([]*oc.Interface, error) {
+			return gnmi.GetAll(t, dev, gnmi.OC().InterfaceAny().State()), nil
+		},
+		networkInstances: func() ([]*oc.NetworkInstance, error) {
+			return gnmi.GetAll(t, dev, gnmi.OC().NetworkInstanceAny().State()), nil
+		},
+		interfaceState: func(name string) (*oc.Interface, error) {
+			return gnmi.Get(t, dev, gnmi.OC().Interface(name).State()), nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Cannot refurbish the device config: %v", err)
+	}
+
+	WriteQuery(t, "Touched", gnmi.OC().Config(), config)
+	return config
+}
+
+// configDevice is the device whose config sanitizeConfig refurbishes.
+type configDevice struct {
+	vendor              ondatra.Vendor
+	skipMacaddressCheck func() bool
+	interfaces          func() ([]*oc.Interface, error)
+	networkInstances    func() ([]*oc.NetworkInstance, error)
+	interfaceState      func(name string) (*oc.Interface, error)
+}
+
+// sanitizeConfig refurbishes a full config from the device enough so it can be pushed
+// out again, using the state of the device where the config is not enough.
+func sanitizeConfig(config *oc.Root, dev *configDevice) error {
 	// load the base oc config from the device state when no oc config is loaded
 	if !*baseOCConfigIsPresent {
-		if ondatra.DUT(t, "dut").Vendor() == ondatra.CISCO {
-			intfsState := gnmi.GetAll(t, dev, gnmi.OC().InterfaceAny().State())
+		if dev.vendor == ondatra.CISCO {
+			intfsState, err := dev.interfaces()
+			if err != nil {
+				return err
+			}
 			for _, intf := range intfsState {
 				ygot.PruneConfigFalse(oc.SchemaTree["Interface"], intf)
 				config.DeleteInterface(intf.GetName())
@@ -58,7 +95,10 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 				}
 				config.AppendInterface(intf)
 			}
-			vrfsStates := gnmi.GetAll(t, dev, gnmi.OC().NetworkInstanceAny().State())
+			vrfsStates, err := dev.networkInstances()
+			if err != nil {
+				return err
+			}
 			for _, vrf := range vrfsStates {
 				// only needed for containerOp
 				if vrf.GetName() == "**iid" {
@@ -108,7 +148,10 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 			}
 			// Ethernet config may not contain meaningful values if it wasn't explicitly
 			// configured, so use its current state for the config, but prune non-config leaves.
-			intf := gnmi.Get(t, dev, gnmi.OC().Interface(iname).State())
+			intf, err := dev.interfaceState(iname)
+			if err != nil {
+				return err
+			}
 			e := intf.GetEthernet()
 			if len(intf.GetHardwarePort()) != 0 {
 				breakout := config.GetComponent(intf.GetHardwarePort()).GetPort().GetBreakoutMode()
@@ -123,11 +166,11 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 				iface.Ethernet = e
 			}
 			// need to set mac address for mgmt interface to nil
-			if intf.GetName() == "MgmtEth0/RP0/CPU0/0" || intf.GetName() == "MgmtEth0/RP1/CPU0/0" && deviations.SkipMacaddressCheck(ondatra.DUT(t, "dut")) {
+			if intf.GetName() == "MgmtEth0/RP0/CPU0/0" || intf.GetName() == "MgmtEth0/RP1/CPU0/0" && dev.skipMacaddressCheck() {
 				e.MacAddress = nil
 			}
 			// need to set mac address for bundle interface to nil
-			if iface.Ethernet.AggregateId != nil && deviations.SkipMacaddressCheck(ondatra.DUT(t, "dut")) {
+			if iface.Ethernet.AggregateId != nil && dev.skipMacaddressCheck() {
 				iface.Ethernet.MacAddress = nil
 				continue
 			}
@@ -155,9 +198,7 @@ func GetDeviceConfig(t testing.TB, dev gnmi.DeviceOrOpts) *oc.Root {
 	}
 
 	pruneUnsupportedPaths(config)
-
-	WriteQuery(t, "Touched", gnmi.OC().Config(), config)
-	return config
+	return nil
 }
 
 // CopyDeviceConfig returns a deep copy of a device config but refurbishes it enough so it can be
@@ -0,0 +1,56 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/internal/deviations"
+	"github.com/openconfig/ondatra/eventlis"
+)
+
+// reportDeviationUsage writes the deviations consulted by the tests to
+// --outputs_dir as JSON once all tests are done.  It does not add suite
+// properties, as the JUnit XML report of Ondatra is complete by then.
+func reportDeviationUsage(*eventlis.AfterTestsEvent) error {
+	text, err := deviations.UsageJSON()
+	if err != nil {
+		return fmt.Errorf("could not render deviation usage: %w", err)
+	}
+	if _, err := WriteOutput("deviations_usage", ".json", string(text)); err != nil {
+		log.Errorf("Could not write deviation usage: %v", err)
+	}
+	return nil
+}
+
+// deviationUsageProperties returns the suite properties that summarize the
+// deviation usage: for each device, the sorted accessors consulted as
+// deviations.<id>.consulted and the deviations in effect as deviations.<id>.set.
+func deviationUsageProperties(usage map[string]*deviations.DeviceUsage) map[string]string {
+	props := make(map[string]string)
+	for id, du := range usage {
+		var consulted []string
+		for accessor := range du.Consulted {
+			consulted = append(consulted, accessor)
+		}
+		sort.Strings(consulted)
+		props[fmt.Sprintf("deviations.%s.consulted", id)] = strings.Join(consulted, ",")
+		props[fmt.Sprintf("deviations.%s.set", id)] = strings.Join(du.Set, ",")
+	}
+	return props
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/featureprofiles/internal/deviations"
+)
+
+func TestDeviationUsageProperties(t *testing.T) {
+	usage := map[string]*deviations.DeviceUsage{
+		"dut": {
+			Matched:   true,
+			Set:       []string{"banner_delimiter", "omit_l2_mtu"},
+			Consulted: map[string]int{"OmitL2MTU": 2, "BannerDelimiter": 1},
+		},
+		"ate": {
+			Set:       []string{},
+			Consulted: map[string]int{"ATEIPv6FlowLabelUnsupported": 1},
+		},
+	}
+	want := map[string]string{
+		"deviations.dut.consulted": "BannerDelimiter,OmitL2MTU",
+		"deviations.dut.set":       "banner_delimiter,omit_l2_mtu",
+		"deviations.ate.consulted": "ATEIPv6FlowLabelUnsupported",
+		"deviations.ate.set":       "",
+	}
+	if diff := cmp.Diff(want, deviationUsageProperties(usage)); diff != "" {
+		t.Errorf("deviationUsageProperties() got unexpected diff (-want, +got):\n%s", diff)
+	}
+}
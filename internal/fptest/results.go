@@ -0,0 +1,391 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/jstemmer/go-junit-report/v2/junit"
+	"github.com/openconfig/featureprofiles/internal/deviations"
+	"github.com/openconfig/ondatra/eventlis"
+	"github.com/openconfig/ondatra/report"
+)
+
+const resultsFlag = "test_results"
+
+var (
+	testResults = flag.Bool(resultsFlag, false, "Write the results of the tests to "+resultsXMLFile+" (JUnit XML) and "+resultsJSONFile+" in --outputs_dir, with the subtest tree, durations, test properties and test outputs.  Ignored with -"+strictFlag+".")
+)
+
+// Names of the result files in --outputs_dir.
+const (
+	resultsXMLFile  = "test_results.xml"
+	resultsJSONFile = "test_results.json"
+)
+
+// artifactPrefix is the prefix of the test properties that name a test output,
+// such as those added by LogQuery.
+const artifactPrefix = "test_output"
+
+// Test outcomes, as reported by "go test -v".
+const (
+	statusPass = "PASS"
+	statusFail = "FAIL"
+	statusSkip = "SKIP"
+)
+
+// packageResult is the result of the tests of a package.
+type packageResult struct {
+	Package       string            `json:"package"`
+	Status        string            `json:"status"`
+	Start         time.Time         `json:"start"`
+	DurationSec   float64           `json:"duration_sec"`
+	KnownIssueURL string            `json:"known_issue_url,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+	Artifacts     []string          `json:"artifacts,omitempty"`
+	Tests         []*testResult     `json:"tests,omitempty"`
+}
+
+// testResult is the result of a test or subtest.  Message is the output of a
+// test that failed or was skipped.
+type testResult struct {
+	Name        string            `json:"name"`
+	Status      string            `json:"status"`
+	DurationSec float64           `json:"duration_sec"`
+	Message     string            `json:"message,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Artifacts   []string          `json:"artifacts,omitempty"`
+	Subtests    []*testResult     `json:"subtests,omitempty"`
+}
+
+// buildResults builds the result of the tests from the JUnit XML report of
+// Ondatra, in which every test and subtest is a test case and the test
+// properties are encoded in the suite properties.
+func buildResults(suites junit.Testsuites) (*packageResult, error) {
+	if len(suites.Suites) != 1 {
+		return nil, fmt.Errorf("JUnit XML report has %d test suites, want 1", len(suites.Suites))
+	}
+	suite := suites.Suites[0]
+	props := report.ExtractProperties(suites)
+	res := &packageResult{
+		Package:       suite.Name,
+		Status:        statusPass,
+		DurationSec:   parseSeconds(suite.Time),
+		KnownIssueURL: props[""]["known_issue_url"],
+		Properties:    props[""],
+	}
+	if p := props[""]["test.path"]; p != "" {
+		res.Package = p
+	}
+	if t, err := time.Parse(time.RFC3339, suite.Timestamp); err == nil {
+		res.Start = t
+	}
+
+	tests := make(map[string]*testResult)
+	for _, tc := range suite.Testcases {
+		tr := &testResult{
+			Name:        tc.Name,
+			Status:      statusPass,
+			DurationSec: parseSeconds(tc.Time),
+			Properties:  props[tc.Name],
+			Artifacts:   artifacts(props[tc.Name]),
+		}
+		switch {
+		case tc.Failure != nil:
+			tr.Status, tr.Message = statusFail, tc.Failure.Data
+		case tc.Error != nil:
+			tr.Status, tr.Message = statusFail, tc.Error.Data
+		case tc.Skipped != nil:
+			tr.Status, tr.Message = statusSkip, tc.Skipped.Data
+		}
+		if tr.Status == statusFail {
+			res.Status = statusFail
+		}
+		tests[tc.Name] = tr
+		if parent := parentTest(tests, tc.Name); parent != nil {
+			parent.Subtests = append(parent.Subtests, tr)
+		} else {
+			res.Tests = append(res.Tests, tr)
+		}
+	}
+	return res, nil
+}
+
+// parentTest returns the closest test of which the named test is a subtest, or
+// nil if it is a top-level test.  Subtest names may contain slashes, so the
+// parent is not necessarily named by the last path element.
+func parentTest(tests map[string]*testResult, name string) *testResult {
+	for i := strings.LastIndexByte(name, '/'); i > 0; i = strings.LastIndexByte(name[:i], '/') {
+		if t, ok := tests[name[:i]]; ok {
+			return t
+		}
+	}
+	return nil
+}
+
+// parseSeconds parses a JUnit XML duration, or returns 0 if it is invalid.
+func parseSeconds(s string) float64 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// artifacts returns the test outputs named by the test properties, in the
+// order of the property names.
+func artifacts(props map[string]string) []string {
+	var names []string
+	for name := range props {
+		if strings.HasPrefix(name, artifactPrefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var paths []string
+	for _, name := range names {
+		paths = append(paths, props[name])
+	}
+	return paths
+}
+
+// testArtifacts returns the set of test outputs attached to the tests.
+func testArtifacts(tests []*testResult) map[string]bool {
+	set := make(map[string]bool)
+	var visit func([]*testResult)
+	visit = func(tests []*testResult) {
+		for _, t := range tests {
+			for _, a := range t.Artifacts {
+				set[a] = true
+			}
+			visit(t.Subtests)
+		}
+	}
+	visit(tests)
+	return set
+}
+
+// outputFiles returns the files in dir modified since the time, relative to
+// dir, except for the result files and the excluded files.
+func outputFiles(dir string, since time.Time, exclude map[string]bool) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || exclude[name] || name == resultsXMLFile || name == resultsJSONFile {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		if !info.ModTime().Before(since) {
+			files = append(files, name)
+		}
+	}
+	return files, nil
+}
+
+// junit returns the result as a JUnit XML report, in which the subtests are
+// test cases named by their full name.  As with the report of Ondatra, the
+// test properties are encoded in the suite properties, so that they can be
+// extracted with report.ExtractProperties; the artifacts are numbered
+// properties named "artifact.N".
+func (r *packageResult) junit() junit.Testsuites {
+	suite := junit.Testsuite{
+		Name: r.Package,
+		Time: formatSeconds(r.DurationSec),
+	}
+	if !r.Start.IsZero() {
+		suite.SetTimestamp(r.Start)
+	}
+	addProps := func(test string, props map[string]string, artifacts []string) {
+		var names []string
+		for name := range props {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			suite.AddProperty(encodeProperty(test, name), props[name])
+		}
+		for i, a := range artifacts {
+			suite.AddProperty(encodeProperty(test, fmt.Sprintf("artifact.%d", i)), a)
+		}
+	}
+	addProps("", r.Properties, r.Artifacts)
+
+	var visit func([]*testResult)
+	visit = func(tests []*testResult) {
+		for _, t := range tests {
+			tc := junit.Testcase{
+				Name:      t.Name,
+				Classname: r.Package,
+				Time:      formatSeconds(t.DurationSec),
+			}
+			switch t.Status {
+			case statusFail:
+				tc.Failure = &junit.Result{Message: "Failed", Data: t.Message}
+			case statusSkip:
+				tc.Skipped = &junit.Result{Message: "Skipped", Data: t.Message}
+			}
+			suite.AddTestcase(tc)
+			addProps(t.Name, t.Properties, t.Artifacts)
+			visit(t.Subtests)
+		}
+	}
+	visit(r.Tests)
+
+	var suites junit.Testsuites
+	suites.AddSuite(suite)
+	return suites
+}
+
+// encodeProperty encodes the name of a test property in a suite property name,
+// in the same way as the report of Ondatra.
+func encodeProperty(test, name string) string {
+	name = strings.ReplaceAll(name, "/", "//")
+	if test == "" {
+		return name
+	}
+	return test + "/" + name
+}
+
+func formatSeconds(sec float64) string {
+	return fmt.Sprintf("%.3f", sec)
+}
+
+// writeResults writes the result files to dir.
+func writeResults(dir string, res *packageResult) error {
+	text, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, resultsJSONFile), append(text, '\n'), 0644); err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	suites := res.junit()
+	if err := suites.WriteXML(&b); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, resultsXMLFile), b.Bytes(), 0644)
+}
+
+// readResults reads the result of the tests from the JUnit XML report written
+// by Ondatra.
+func readResults(xmlPath string) (*packageResult, error) {
+	f, err := os.Open(xmlPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	suites, err := report.ReadXML(f)
+	if err != nil {
+		return nil, err
+	}
+	return buildResults(suites)
+}
+
+// testResultsWriter writes the result files to --outputs_dir after the tests,
+// from the JUnit XML report that Ondatra writes while the tests run and the
+// properties only known after the tests.
+type testResultsWriter struct {
+	start     time.Time
+	xmlPath   string
+	tmpXML    bool             // Whether the report is a temporary file to remove.
+	snapshots *configSnapshots // May be nil.
+}
+
+// newTestResultsWriter makes Ondatra write its JUnit XML report, to a
+// temporary file unless -xml is given.  The config snapshots, if not nil, must
+// be restored before the results are written.
+func newTestResultsWriter(snapshots *configSnapshots) (*testResultsWriter, error) {
+	f := flag.Lookup("xml")
+	if f == nil {
+		return nil, errors.New("the -xml flag of Ondatra is not defined")
+	}
+	w := &testResultsWriter{start: time.Now(), xmlPath: f.Value.String(), snapshots: snapshots}
+	if w.xmlPath == "" {
+		tmp, err := os.CreateTemp("", "fptest_*.xml")
+		if err != nil {
+			return nil, fmt.Errorf("could not create JUnit XML file: %w", err)
+		}
+		tmp.Close()
+		w.xmlPath, w.tmpXML = tmp.Name(), true
+		if err := f.Value.Set(w.xmlPath); err != nil {
+			os.Remove(w.xmlPath)
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// write is an AfterTests callback that writes the result files.  The JUnit
+// XML report is complete by then, as Ondatra stops writing it before calling
+// the AfterTests callbacks, so the deviation usage and the unrestored leaves
+// of the config snapshots are added to the package properties here.
+func (w *testResultsWriter) write(e *eventlis.AfterTestsEvent) error {
+	if w.tmpXML {
+		defer os.Remove(w.xmlPath)
+	}
+	res, rerr := readResults(w.xmlPath)
+	if rerr != nil {
+		log.Errorf("Could not read test results: %v", rerr)
+		res = &packageResult{}
+	}
+	if res.Package == "" {
+		res.Package = strings.TrimSuffix(filepath.Base(os.Args[0]), ".test")
+	}
+	res.Start = w.start
+	res.DurationSec = time.Since(w.start).Seconds()
+	props := deviationUsageProperties(deviations.Usage())
+	if w.snapshots != nil {
+		maps.Copy(props, w.snapshots.properties())
+	}
+	if len(props) > 0 && res.Properties == nil {
+		res.Properties = make(map[string]string)
+	}
+	maps.Copy(res.Properties, props)
+	if e.ExitCode == nil || *e.ExitCode != 0 || rerr != nil {
+		res.Status = statusFail
+	}
+	files, ferr := outputFiles(*outputsDir, w.start, testArtifacts(res.Tests))
+	if ferr != nil {
+		log.Errorf("Could not list test outputs: %v", ferr)
+	}
+	res.Artifacts = append(res.Artifacts, files...)
+
+	if err := writeResults(*outputsDir, res); err != nil {
+		return fmt.Errorf("could not write test results: %w", err)
+	}
+	log.Infof("Test results written to %s", filepath.Join(*outputsDir, resultsJSONFile))
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jstemmer/go-junit-report/v2/junit"
+	"github.com/openconfig/ondatra/eventlis"
+	"github.com/openconfig/ondatra/report"
+)
+
+const ondatraXML = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="5" failures="2" skipped="1">
+	<testsuite name="" tests="5" failures="2" errors="0" id="0" skipped="1" time="2.500" timestamp="2025-01-01T00:00:00Z">
+		<properties>
+			<property name="test.path" value="feature/foo/tests/foo_test"></property>
+			<property name="dut.vendor" value="ARISTA"></property>
+			<property name="known_issue_url" value="https://example.com/issue/1"></property>
+			<property name="TestFoo/bar/test_output0" value="TestFoo_bar_Config.json"></property>
+			<property name="TestFoo/bar/step" value="1"></property>
+		</properties>
+		<testcase name="TestFoo" classname="" time="1.500">
+			<failure message="Failed"><![CDATA[]]></failure>
+		</testcase>
+		<testcase name="TestFoo/bar" classname="" time="1.000"></testcase>
+		<testcase name="TestFoo/baz/qux" classname="" time="0.500">
+			<failure message="Failed"><![CDATA[    foo_test.go:12: oops]]></failure>
+		</testcase>
+		<testcase name="TestQux" classname="" time="1.000">
+			<skipped message="Skipped"><![CDATA[    qux_test.go:8: no ATE]]></skipped>
+		</testcase>
+		<testcase name="TestQuux" classname="" time="0.000"></testcase>
+	</testsuite>
+</testsuites>
+`
+
+func TestBuildResults(t *testing.T) {
+	suites, err := report.ReadXML(strings.NewReader(ondatraXML))
+	if err != nil {
+		t.Fatalf("ReadXML() got error: %v", err)
+	}
+	got, err := buildResults(suites)
+	if err != nil {
+		t.Fatalf("buildResults() got error: %v", err)
+	}
+	want := &packageResult{
+		Package:       "feature/foo/tests/foo_test",
+		Status:        "FAIL",
+		Start:         time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		DurationSec:   2.5,
+		KnownIssueURL: "https://example.com/issue/1",
+		Properties: map[string]string{
+			"test.path":       "feature/foo/tests/foo_test",
+			"dut.vendor":      "ARISTA",
+			"known_issue_url": "https://example.com/issue/1",
+		},
+		Tests: []*testResult{{
+			Name:        "TestFoo",
+			Status:      "FAIL",
+			DurationSec: 1.5,
+			Subtests: []*testResult{{
+				Name:        "TestFoo/bar",
+				Status:      "PASS",
+				DurationSec: 1,
+				Properties:  map[string]string{"test_output0": "TestFoo_bar_Config.json", "step": "1"},
+				Artifacts:   []string{"TestFoo_bar_Config.json"},
+			}, {
+				// A subtest name with a slash.
+				Name:        "TestFoo/baz/qux",
+				Status:      "FAIL",
+				DurationSec: 0.5,
+				Message:     "    foo_test.go:12: oops",
+			}},
+		}, {
+			Name:        "TestQux",
+			Status:      "SKIP",
+			DurationSec: 1,
+			Message:     "    qux_test.go:8: no ATE",
+		}, {
+			Name:   "TestQuux",
+			Status: "PASS",
+		}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildResults() got unexpected diff (-want,+got):\n%s", diff)
+	}
+
+	if _, err := buildResults(junit.Testsuites{}); err == nil {
+		t.Errorf("buildResults() of no test suites got no error, want error")
+	}
+}
+
+func TestPackageResultJUnit(t *testing.T) {
+	res := &packageResult{
+		Package:     "feature/foo/tests/foo_test",
+		Status:      "FAIL",
+		DurationSec: 2,
+		Properties:  map[string]string{"git.commit": "abc", "a/b": "c"},
+		Artifacts:   []string{"deviations_usage.json"},
+		Tests: []*testResult{{
+			Name:        "TestFoo",
+			Status:      "FAIL",
+			DurationSec: 2,
+			Subtests: []*testResult{{
+				Name:       "TestFoo/bar",
+				Status:     "FAIL",
+				Message:    "oops",
+				Properties: map[string]string{"test_output0": "out.json"},
+				Artifacts:  []string{"out.json"},
+			}},
+		}},
+	}
+	suites := res.junit()
+	if got, want := len(suites.Suites), 1; got != want {
+		t.Fatalf("junit() got %d test suites, want %d", got, want)
+	}
+	var names []string
+	for _, tc := range suites.Suites[0].Testcases {
+		names = append(names, tc.Name)
+	}
+	if diff := cmp.Diff([]string{"TestFoo", "TestFoo/bar"}, names); diff != "" {
+		t.Errorf("junit() got unexpected diff of test cases (-want,+got):\n%s", diff)
+	}
+	if got, want := suites.Failures, 2; got != want {
+		t.Errorf("junit() got %d failures, want %d", got, want)
+	}
+	wantProps := map[string]map[string]string{
+		"":            {"git.commit": "abc", "a/b": "c", "artifact.0": "deviations_usage.json"},
+		"TestFoo/bar": {"test_output0": "out.json", "artifact.0": "out.json"},
+	}
+	if diff := cmp.Diff(wantProps, report.ExtractProperties(suites)); diff != "" {
+		t.Errorf("junit() got unexpected diff of properties (-want,+got):\n%s", diff)
+	}
+}
+
+func TestWriteResults(t *testing.T) {
+	dir := t.TempDir()
+	res := &packageResult{
+		Package: "foo",
+		Status:  "PASS",
+		Tests:   []*testResult{{Name: "TestFoo", Status: "PASS"}},
+	}
+	if err := writeResults(dir, res); err != nil {
+		t.Fatalf("writeResults() got error: %v", err)
+	}
+	text, err := os.ReadFile(filepath.Join(dir, resultsJSONFile))
+	if err != nil {
+		t.Fatalf("ReadFile() got error: %v", err)
+	}
+	got := new(packageResult)
+	if err := json.Unmarshal(text, got); err != nil {
+		t.Fatalf("Unmarshal() got error: %v", err)
+	}
+	if diff := cmp.Diff(res, got); diff != "" {
+		t.Errorf("writeResults() got unexpected diff of JSON (-want,+got):\n%s", diff)
+	}
+	got, err = readResults(filepath.Join(dir, resultsXMLFile))
+	if err != nil {
+		t.Fatalf("readResults() got error: %v", err)
+	}
+	if diff := cmp.Diff(res.Tests, got.Tests); diff != "" {
+		t.Errorf("writeResults() got unexpected diff of JUnit XML tests (-want,+got):\n%s", diff)
+	}
+}
+
+func TestOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	since := time.Now().Add(-time.Minute)
+	for _, name := range []string{"old.txt", "new.txt", "test.json", resultsJSONFile} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatalf("WriteFile() got error: %v", err)
+		}
+	}
+	old := since.Add(-time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "old.txt"), old, old); err != nil {
+		t.Fatalf("Chtimes() got error: %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "subdir"), 0755); err != nil {
+		t.Fatalf("Mkdir() got error: %v", err)
+	}
+	got, err := outputFiles(dir, since, map[string]bool{"test.json": true})
+	if err != nil {
+		t.Fatalf("outputFiles() got error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"new.txt"}, got); diff != "" {
+		t.Errorf("outputFiles() got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestTestResultsWriter(t *testing.T) {
+	dir := t.TempDir()
+	xmlPath := filepath.Join(t.TempDir(), "ondatra.xml")
+	if err := os.WriteFile(xmlPath, []byte(ondatraXML), 0644); err != nil {
+		t.Fatalf("WriteFile() got error: %v", err)
+	}
+	defer func(dir string) { *outputsDir = dir }(*outputsDir)
+	*outputsDir = dir
+
+	snapshots := newConfigSnapshots()
+	snapshots.unrestored["dut"] = 2
+	w := &testResultsWriter{start: time.Now(), xmlPath: xmlPath, tmpXML: true, snapshots: snapshots}
+	code := 0
+	if err := w.write(&eventlis.AfterTestsEvent{ExitCode: &code}); err != nil {
+		t.Fatalf("write() got error: %v", err)
+	}
+	if _, err := os.Stat(xmlPath); !os.IsNotExist(err) {
+		t.Errorf("write() did not remove the temporary JUnit XML file: %v", err)
+	}
+	got, err := readResults(filepath.Join(dir, resultsXMLFile))
+	if err != nil {
+		t.Fatalf("readResults() got error: %v", err)
+	}
+	if got.Package != "feature/foo/tests/foo_test" || got.Status != statusFail || len(got.Tests) != 3 {
+		t.Errorf("write() got package %q, status %s and %d tests, want feature/foo/tests/foo_test, %s and 3", got.Package, got.Status, len(got.Tests), statusFail)
+	}
+	if got, want := got.Properties["config_snapshot.dut.unrestored"], "2"; got != want {
+		t.Errorf("write() got unrestored property %q, want %q", got, want)
+	}
+	if got, want := got.Properties["dut.vendor"], "ARISTA"; got != want {
+		t.Errorf("write() got dut.vendor property %q, want %q", got, want)
+	}
+}
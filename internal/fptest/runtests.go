@@ -17,6 +17,7 @@ package fptest
 import (
 	"flag"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -24,7 +25,6 @@ import (
 	log "github.com/golang/glog"
 	"github.com/openconfig/featureprofiles/internal/metadata"
 	"github.com/openconfig/featureprofiles/internal/pathutil"
-	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
 	"github.com/openconfig/featureprofiles/topologies/binding"
 	"github.com/openconfig/ondatra"
 	"github.com/openconfig/ygnmi/ygnmi"
@@ -40,11 +40,48 @@ import (
 //	func TestMain(m *testing.M) {
 //	  fptest.RunTests(m)
 //	}
+//
+// With -deviations_strict, the tests are run twice in child processes, with
+// and without deviations, and the subtests whose outcome differs are reported.
+//
+// With -config_snapshot, the OpenConfig config of every DUT is written to
+// --outputs_dir before the tests and restored with a gNMI replace after the
+// tests, and the leaves that could not be restored are reported.
+//
+// With -test_results, the results of the tests are written to --outputs_dir
+// as JUnit XML and JSON files after the tests: the tree of subtests with their
+// outcome and duration, the test properties such as the rundata of the DUTs,
+// the deviations consulted and the leaves that config snapshots could not
+// restore, and the test outputs.  They are built from the JUnit XML report of
+// Ondatra, which is enabled if -xml is not given.
 func RunTests(m *testing.M) {
 	if err := initMetadata(); err != nil {
 		log.Errorf("Unable to initialize test metadata: %v", err)
 	}
+	if *deviationsStrict {
+		os.Exit(runStrict())
+	}
 	ygnmi.WithDatapointValidator(datapointValidator)
+	ondatra.EventListener().AddAfterTestsCallback(reportDeviationUsage)
+	var snapshots *configSnapshots
+	if *configSnapshot {
+		snapshots = newConfigSnapshots()
+		ondatra.EventListener().AddBeforeTestsCallback(snapshots.capture)
+		ondatra.EventListener().AddAfterTestsCallback(snapshots.restore)
+	}
+	if *testResults {
+		if *outputsDir != "" {
+			if w, err := newTestResultsWriter(snapshots); err != nil {
+				log.Errorf("Unable to write test results: %v", err)
+			} else {
+				// The AfterTests callbacks run in order, so the results
+				// include the outputs of the others.
+				ondatra.EventListener().AddAfterTestsCallback(w.write)
+			}
+		} else {
+			log.Warningf("Test results are discarded without -outputs_dir.  Please specify -outputs_dir to keep them.")
+		}
+	}
 	ondatra.RunTests(m, binding.New)
 }
 
@@ -69,23 +106,9 @@ func initMetadata() error {
 }
 
 func testbedPathFromMetadata() (string, error) {
-	testbed := metadata.Get().Testbed
-	testbedToFile := map[mpb.Metadata_Testbed]string{
-		mpb.Metadata_TESTBED_DUT:                   "dut.testbed",
-		mpb.Metadata_TESTBED_DUT_DUT_4LINKS:        "dutdut.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_2LINKS:        "atedut_2.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_4LINKS:        "atedut_4.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_9LINKS_LAG:    "atedut_9_lag.testbed",
-		mpb.Metadata_TESTBED_DUT_DUT_ATE_2LINKS:    "dutdutate.testbed",
-		mpb.Metadata_TESTBED_DUT_ATE_8LINKS:        "atedut_8.testbed",
-		mpb.Metadata_TESTBED_DUT_400ZR:             "dut_400zr.testbed",
-		mpb.Metadata_TESTBED_DUT_400ZR_PLUS:        "dut_400zr_plus.testbed",
-		mpb.Metadata_TESTBED_DUT_400ZR_100G_4LINKS: "dut_400zr_100g_4links.testbed",
-		mpb.Metadata_TESTBED_DUT_400FR_100G_4LINKS: "dut_400fr_100g_4links.testbed",
-	}
-	testbedFile, ok := testbedToFile[testbed]
-	if !ok {
-		return "", fmt.Errorf("no testbed file for testbed %v", testbed)
+	testbedFile, err := metadata.TestbedFile(metadata.Get().GetTestbed())
+	if err != nil {
+		return "", err
 	}
 	rootPath, err := pathutil.RootPath()
 	if err != nil {
@@ -0,0 +1,307 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/eventlis"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+)
+
+var configSnapshot = flag.Bool("config_snapshot", false, "Snapshot the OpenConfig config of every DUT before the tests, and restore it with a gNMI replace after the tests.")
+
+// configSnapshots holds the configs of the DUTs captured before the tests, so
+// that they can be restored after the tests.
+type configSnapshots struct {
+	duts       map[string]binding.DUT
+	configs    map[string]*oc.Root // Config refurbished as by GetDeviceConfig, by DUT ID.
+	unrestored map[string]int      // Number of leaves not restored, by DUT ID.
+}
+
+func newConfigSnapshots() *configSnapshots {
+	return &configSnapshots{
+		duts:       make(map[string]binding.DUT),
+		configs:    make(map[string]*oc.Root),
+		unrestored: make(map[string]int),
+	}
+}
+
+// capture gets the config of every DUT of the reservation and writes it to
+// --outputs_dir.
+func (s *configSnapshots) capture(e *eventlis.BeforeTestsEvent) error {
+	ctx := context.Background()
+	for _, id := range sortedDUTIDs(e.Reservation.DUTs) {
+		dut := e.Reservation.DUTs[id]
+		config, err := getConfig(ctx, dut)
+		if err != nil {
+			return fmt.Errorf("could not snapshot the config of %s: %w", dut.Name(), err)
+		}
+		s.duts[id] = dut
+		s.configs[id] = config
+
+		configJSON, err := marshalConfig(config)
+		if err != nil {
+			log.Errorf("Could not marshal config snapshot of %s: %v", dut.Name(), err)
+			continue
+		}
+		filename, err := WriteOutput("config_snapshot_"+id, ".json", string(configJSON))
+		if err != nil {
+			log.Errorf("Could not write config snapshot of %s: %v", dut.Name(), err)
+			continue
+		}
+		if filename != "" {
+			ondatra.Report().AddSuiteProperty(fmt.Sprintf("config_snapshot.%s", id), filename)
+		}
+	}
+	return nil
+}
+
+// restore replaces the config of every DUT with its snapshot, then reports
+// the leaves whose value differs from the snapshot after the replace.  Their
+// number is kept for the test results, as the JUnit XML report of Ondatra is
+// complete by then.
+func (s *configSnapshots) restore(*eventlis.AfterTestsEvent) error {
+	ctx := context.Background()
+	var errs []error
+	for _, id := range sortedDUTIDs(s.duts) {
+		dut := s.duts[id]
+		if err := replaceConfig(ctx, dut, s.configs[id]); err != nil {
+			errs = append(errs, fmt.Errorf("could not restore the config of %s: %w", dut.Name(), err))
+			continue
+		}
+		got, err := getConfig(ctx, dut)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not check the restored config of %s: %w", dut.Name(), err))
+			continue
+		}
+		diffs, err := diffConfigs(s.configs[id], got)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not check the restored config of %s: %w", dut.Name(), err))
+			continue
+		}
+		s.unrestored[id] = len(diffs)
+		if len(diffs) == 0 {
+			log.Infof("Restored the config of %s", dut.Name())
+			continue
+		}
+		log.Warningf("%d leaves of %s could not be restored:\n%s", len(diffs), dut.Name(), strings.Join(diffs, "\n"))
+		if _, err := WriteOutput("config_unrestored_"+id, ".txt", strings.Join(diffs, "\n")+"\n"); err != nil {
+			log.Errorf("Could not write unrestored leaves of %s: %v", dut.Name(), err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sortedDUTIDs(duts map[string]binding.DUT) []string {
+	var ids []string
+	for id := range duts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// configClient returns a client of the DUT that, as Ondatra does, gets the
+// config of the vendors that require it with a gNMI Get.
+func configClient(ctx context.Context, dut binding.DUT) (*ygnmi.Client, []ygnmi.Option, error) {
+	gnmic, err := dut.DialGNMI(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := ygnmi.NewClient(gnmic, ygnmi.WithTarget(dut.Name()))
+	if err != nil {
+		return nil, nil, err
+	}
+	var opts []ygnmi.Option
+	switch ondatra.Vendor(dut.Vendor()) {
+	case ondatra.CISCO, ondatra.JUNIPER, ondatra.NOKIA:
+		opts = append(opts, ygnmi.WithUseGet())
+	}
+	return client, opts, nil
+}
+
+// getConfig gets the whole OpenConfig config of the DUT, refurbished as by
+// GetDeviceConfig so that it can be pushed out again.  The deviations of the
+// DUT cannot be looked up outside of a test, so the config is refurbished as
+// for a DUT without the skip_macaddress_check deviation.
+func getConfig(ctx context.Context, dut binding.DUT) (*oc.Root, error) {
+	client, opts, err := configClient(ctx, dut)
+	if err != nil {
+		return nil, err
+	}
+	config, err := ygnmi.Get(ctx, client, gnmi.OC().Config(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	err = sanitizeConfig(config, &configDevice{
+		vendor:              ondatra.Vendor(dut.Vendor()),
+		skipMacaddressCheck: func() bool { return false },
+		interfaces: func() ([]*oc.Interface, error) {
+			return ygnmi.GetAll(ctx, client, gnmi.OC().InterfaceAny().State())
+		},
+		networkInstances: func() ([]*oc.NetworkInstance, error) {
+			return ygnmi.GetAll(ctx, client, gnmi.OC().NetworkInstanceAny().State())
+		},
+		interfaceState: func(name string) (*oc.Interface, error) {
+			return ygnmi.Get(ctx, client, gnmi.OC().Interface(name).State())
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// replaceConfig replaces the whole OpenConfig config of the DUT.
+func replaceConfig(ctx context.Context, dut binding.DUT, config *oc.Root) error {
+	client, _, err := configClient(ctx, dut)
+	if err != nil {
+		return err
+	}
+	_, err = ygnmi.Replace(ctx, client, gnmi.OC().Config(), config)
+	return err
+}
+
+// marshalConfig returns the config in RFC 7951 JSON, as it is sent by a gNMI
+// replace.
+func marshalConfig(config *oc.Root) ([]byte, error) {
+	data, err := ygot.Marshal7951(config, ygot.JSONIndent("  "), &ygot.RFC7951JSONConfig{AppendModuleName: true, PreferShadowPath: true})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// diffConfigs compares the leaves of two configs and returns one line for
+// each leaf that differs, sorted by path.
+func diffConfigs(want, got *oc.Root) ([]string, error) {
+	wantJSON, err := marshalConfig(want)
+	if err != nil {
+		return nil, err
+	}
+	gotJSON, err := marshalConfig(got)
+	if err != nil {
+		return nil, err
+	}
+	return diffConfigLeaves(wantJSON, gotJSON)
+}
+
+// diffConfigLeaves compares the leaves of two JSON configs and returns one line
+// for each leaf that differs, sorted by path.
+func diffConfigLeaves(want, got []byte) ([]string, error) {
+	wantLeaves, err := configLeaves(want)
+	if err != nil {
+		return nil, err
+	}
+	gotLeaves, err := configLeaves(got)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []string
+	for path, w := range wantLeaves {
+		switch g, ok := gotLeaves[path]; {
+		case !ok:
+			diffs = append(diffs, fmt.Sprintf("%s: want %s, got none", path, w))
+		case g != w:
+			diffs = append(diffs, fmt.Sprintf("%s: want %s, got %s", path, w, g))
+		}
+	}
+	for path, g := range gotLeaves {
+		if _, ok := wantLeaves[path]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s: want none, got %s", path, g))
+		}
+	}
+	sort.Strings(diffs)
+	return diffs, nil
+}
+
+// configLeaves flattens a JSON config into its leaves, keyed by path.  The
+// entries of a list are keyed by their scalar members, which in OpenConfig are
+// the list keys, e.g. /interfaces/interface[name=eth0]/config/mtu.  The values
+// are in JSON, and a leaf-list is a single leaf with its values sorted.
+func configLeaves(config []byte) (map[string]string, error) {
+	var root any
+	if err := json.Unmarshal(config, &root); err != nil {
+		return nil, err
+	}
+	leaves := make(map[string]string)
+	addLeaves("", root, leaves)
+	return leaves, nil
+}
+
+func addLeaves(path string, v any, leaves map[string]string) {
+	switch v := v.(type) {
+	case map[string]any:
+		for name, child := range v {
+			addLeaves(path+"/"+name, child, leaves)
+		}
+	case []any:
+		var values []string
+		for _, elem := range v {
+			entry, ok := elem.(map[string]any)
+			if !ok {
+				values = append(values, jsonString(elem))
+				continue
+			}
+			var keys []string
+			for name, member := range entry {
+				switch member.(type) {
+				case map[string]any, []any:
+				default:
+					keys = append(keys, fmt.Sprintf("%s=%v", name, member))
+				}
+			}
+			sort.Strings(keys)
+			addLeaves(path+"["+strings.Join(keys, "][")+"]", entry, leaves)
+		}
+		if len(values) > 0 {
+			sort.Strings(values)
+			leaves[path] = "[" + strings.Join(values, ",") + "]"
+		}
+	default:
+		leaves[path] = jsonString(v)
+	}
+}
+
+func jsonString(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// properties returns the suite properties that report the number of leaves
+// that could not be restored, as config_snapshot.<id>.unrestored.
+func (s *configSnapshots) properties() map[string]string {
+	props := make(map[string]string)
+	for id, n := range s.unrestored {
+		props[fmt.Sprintf("config_snapshot.%s.unrestored", id)] = fmt.Sprint(n)
+	}
+	return props
+}
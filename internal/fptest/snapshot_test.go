@@ -0,0 +1,62 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffConfigLeaves(t *testing.T) {
+	want := `{
+  "openconfig-system:system": {"config": {"hostname": "dut1"}},
+  "openconfig-interfaces:interfaces": {"interface": [
+    {"name": "eth0", "config": {"name": "eth0", "mtu": 1500}},
+    {"name": "eth1", "config": {"name": "eth1", "description": "uplink"}}
+  ]},
+  "openconfig-system:dns": {"config": {"search": ["a.example", "b.example"]}}
+}`
+	got := `{
+  "openconfig-system:system": {"config": {"hostname": "dut1"}},
+  "openconfig-interfaces:interfaces": {"interface": [
+    {"name": "eth1", "config": {"name": "eth1"}},
+    {"name": "eth0", "config": {"name": "eth0", "mtu": 9000}},
+    {"name": "eth2", "config": {"name": "eth2"}}
+  ]},
+  "openconfig-system:dns": {"config": {"search": ["b.example", "a.example"]}}
+}`
+
+	diffs, err := diffConfigLeaves([]byte(want), []byte(got))
+	if err != nil {
+		t.Fatalf("diffConfigLeaves() got error: %v", err)
+	}
+	wantDiffs := []string{
+		`/openconfig-interfaces:interfaces/interface[name=eth0]/config/mtu: want 1500, got 9000`,
+		`/openconfig-interfaces:interfaces/interface[name=eth1]/config/description: want "uplink", got none`,
+		`/openconfig-interfaces:interfaces/interface[name=eth2]/config/name: want none, got "eth2"`,
+		`/openconfig-interfaces:interfaces/interface[name=eth2]/name: want none, got "eth2"`,
+	}
+	if diff := cmp.Diff(wantDiffs, diffs); diff != "" {
+		t.Errorf("diffConfigLeaves() got unexpected diff (-want, +got):\n%s", diff)
+	}
+
+	if diffs, err := diffConfigLeaves([]byte(want), []byte(want)); err != nil || len(diffs) != 0 {
+		t.Errorf("diffConfigLeaves() of the same config got %v, %v, want no diffs", diffs, err)
+	}
+	if _, err := diffConfigLeaves([]byte(want), []byte("not JSON")); err == nil {
+		t.Errorf("diffConfigLeaves() of invalid JSON got no error, want error")
+	}
+}
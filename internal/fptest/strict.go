@@ -0,0 +1,209 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/internal/deviations"
+)
+
+const strictFlag = "deviations_strict"
+
+var (
+	deviationsStrict = flag.Bool(strictFlag, false, "Run the tests twice, first with the deviations from metadata.textproto and then with the deviations named by -"+deviations.DisableFlag+" forced off (all deviations if unset), and report the subtests whose outcome differs.  Subtests that pass both times indicate deviations that can be removed.")
+)
+
+// resultRE matches the result lines of "go test -v", e.g.
+// "    --- PASS: TestFoo/bar (1.23s)".
+var resultRE = regexp.MustCompile(`^\s*--- (PASS|FAIL|SKIP): (\S+) \(`)
+
+// parseResults copies the test output from r to w and returns the outcome of
+// every test and subtest found in it.
+func parseResults(r io.Reader, w io.Writer) (map[string]string, error) {
+	results := make(map[string]string)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		fmt.Fprintln(w, line)
+		if m := resultRE.FindStringSubmatch(line); m != nil {
+			results[m[2]] = m[1]
+		}
+	}
+	return results, sc.Err()
+}
+
+// resultDiff is a test whose outcome differs between two runs.
+type resultDiff struct {
+	Test       string
+	Deviated   string
+	Undeviated string
+}
+
+// compareResults returns the tests whose outcome differs between the deviated
+// and undeviated runs, sorted by test name.  A test missing from a run is
+// reported with the outcome "NONE".
+func compareResults(deviated, undeviated map[string]string) []resultDiff {
+	tests := make(map[string]bool)
+	for name := range deviated {
+		tests[name] = true
+	}
+	for name := range undeviated {
+		tests[name] = true
+	}
+	var diffs []resultDiff
+	for name := range tests {
+		d, u := deviated[name], undeviated[name]
+		if d == u {
+			continue
+		}
+		if d == "" {
+			d = "NONE"
+		}
+		if u == "" {
+			u = "NONE"
+		}
+		diffs = append(diffs, resultDiff{Test: name, Deviated: d, Undeviated: u})
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Test < diffs[j].Test })
+	return diffs
+}
+
+// formatComparison renders the comparison of the deviated and undeviated runs.
+func formatComparison(disabled string, deviated, undeviated map[string]string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Deviations disabled in the undeviated run: %s\n", disabled)
+	var passBoth []string
+	for name, outcome := range deviated {
+		if outcome == "PASS" && undeviated[name] == "PASS" {
+			passBoth = append(passBoth, name)
+		}
+	}
+	sort.Strings(passBoth)
+	fmt.Fprintf(&b, "Tests passing with and without deviations: %d\n", len(passBoth))
+	for _, name := range passBoth {
+		fmt.Fprintf(&b, "  %s\n", name)
+	}
+	diffs := compareResults(deviated, undeviated)
+	fmt.Fprintf(&b, "Tests with different outcomes: %d\n", len(diffs))
+	if len(diffs) > 0 {
+		tw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  TEST\tDEVIATED\tUNDEVIATED")
+		for _, d := range diffs {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", d.Test, d.Deviated, d.Undeviated)
+		}
+		tw.Flush()
+	}
+	return b.String()
+}
+
+// childArgs returns the command line arguments for a child run, with the
+// strict and results flags and any -deviations_disable flag removed, and
+// verbose output turned on so that the subtest results can be parsed.
+func childArgs(args []string) []string {
+	out := removeFlags(args, []string{strictFlag, resultsFlag}, []string{deviations.DisableFlag})
+	return append(out, "-test.v=true")
+}
+
+// removeFlags returns args without the named boolean flags and the named
+// flags that take a value, which may be given as a separate argument.
+func removeFlags(args []string, boolFlags, valueFlags []string) []string {
+	var out []string
+	for i := 0; i < len(args); i++ {
+		name, _, hasValue := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		if !strings.HasPrefix(args[i], "-") {
+			out = append(out, args[i])
+			continue
+		}
+		switch {
+		case slices.Contains(boolFlags, name):
+			continue
+		case slices.Contains(valueFlags, name):
+			if !hasValue {
+				i++ // Skip the separate value.
+			}
+			continue
+		}
+		out = append(out, args[i])
+	}
+	return out
+}
+
+// runChild runs the test binary with args and returns the test outcomes and
+// exit code.
+func runChild(args []string) (map[string]string, int, error) {
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, 0, err
+	}
+	results, perr := parseResults(stdout, os.Stdout)
+	err = cmd.Wait()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		return results, exitErr.ExitCode(), perr
+	case err != nil:
+		return nil, 0, err
+	}
+	return results, 0, perr
+}
+
+// runStrict runs the tests with and without deviations in child processes,
+// reports the comparison and returns the exit code of the deviated run.
+func runStrict() int {
+	disabled := deviations.DisableAll
+	if f := flag.Lookup(deviations.DisableFlag); f != nil && f.Value.String() != "" {
+		disabled = f.Value.String()
+	}
+	args := childArgs(os.Args[1:])
+
+	log.Infof("Running tests with deviations")
+	deviated, code, err := runChild(args)
+	if err != nil {
+		log.Errorf("Could not run tests with deviations: %v", err)
+		return 1
+	}
+	log.Infof("Running tests with deviations disabled: %s", disabled)
+	undeviated, _, err := runChild(append(args, fmt.Sprintf("-%s=%s", deviations.DisableFlag, disabled)))
+	if err != nil {
+		log.Errorf("Could not run tests without deviations: %v", err)
+		return 1
+	}
+
+	text := formatComparison(disabled, deviated, undeviated)
+	fmt.Print(text)
+	if _, err := WriteOutput("deviations_strict", ".txt", text); err != nil {
+		log.Errorf("Could not write deviation comparison: %v", err)
+	}
+	return code
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptest
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseResults(t *testing.T) {
+	const out = `=== RUN   TestFoo
+=== RUN   TestFoo/bar
+    --- PASS: TestFoo/bar (1.00s)
+=== RUN   TestFoo/baz
+    foo_test.go:12: oops
+    --- FAIL: TestFoo/baz (0.50s)
+--- FAIL: TestFoo (1.50s)
+--- SKIP: TestQux (0.00s)
+FAIL
+`
+	got, err := parseResults(strings.NewReader(out), io.Discard)
+	if err != nil {
+		t.Fatalf("parseResults() got error: %v", err)
+	}
+	want := map[string]string{
+		"TestFoo":     "FAIL",
+		"TestFoo/bar": "PASS",
+		"TestFoo/baz": "FAIL",
+		"TestQux":     "SKIP",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseResults() -want,+got:\n%s", diff)
+	}
+}
+
+func TestCompareResults(t *testing.T) {
+	deviated := map[string]string{
+		"TestFoo":     "PASS",
+		"TestFoo/bar": "PASS",
+		"TestFoo/baz": "PASS",
+	}
+	undeviated := map[string]string{
+		"TestFoo":     "FAIL",
+		"TestFoo/bar": "PASS",
+		"TestFoo/baz": "FAIL",
+		"TestFoo/qux": "SKIP",
+	}
+	want := []resultDiff{
+		{Test: "TestFoo", Deviated: "PASS", Undeviated: "FAIL"},
+		{Test: "TestFoo/baz", Deviated: "PASS", Undeviated: "FAIL"},
+		{Test: "TestFoo/qux", Deviated: "NONE", Undeviated: "SKIP"},
+	}
+	if diff := cmp.Diff(want, compareResults(deviated, undeviated)); diff != "" {
+		t.Errorf("compareResults() -want,+got:\n%s", diff)
+	}
+}
+
+func TestChildArgs(t *testing.T) {
+	args := []string{
+		"-testbed", "foo.testbed",
+		"--deviations_strict",
+		"-test_results=true",
+		"-deviations_disable", "omit_l2_mtu",
+		"-deviations_disable=all",
+		"-binding=foo.binding",
+	}
+	want := []string{"-testbed", "foo.testbed", "-binding=foo.binding", "-test.v=true"}
+	if diff := cmp.Diff(want, childArgs(args)); diff != "" {
+		t.Errorf("childArgs() -want,+got:\n%s", diff)
+	}
+}
@@ -2,7 +2,9 @@
 package metadata
 
 import (
+	"fmt"
 	"os"
+	"time"
 
 	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
 	"google.golang.org/protobuf/encoding/prototext"
@@ -27,3 +29,46 @@ func Init() error {
 func Get() *mpb.Metadata {
 	return md
 }
+
+// expiryDateLayout is the format of PlatformExceptions.expiry_date.
+const expiryDateLayout = time.DateOnly
+
+// Expired returns whether the platform exception has an expiry_date that lies
+// before the date of now.  An exception without expiry_date never expires.
+func Expired(pe *mpb.Metadata_PlatformExceptions, now time.Time) (bool, error) {
+	date := pe.GetExpiryDate()
+	if date == "" {
+		return false, nil
+	}
+	expiry, err := time.ParseInLocation(expiryDateLayout, date, now.Location())
+	if err != nil {
+		return false, fmt.Errorf("bad expiry_date %q, want YYYY-MM-DD: %w", date, err)
+	}
+	return !now.Before(expiry.AddDate(0, 0, 1)), nil
+}
+
+// testbedFiles maps the testbeds to their file under the topologies directory.
+var testbedFiles = map[mpb.Metadata_Testbed]string{
+	mpb.Metadata_TESTBED_DUT:                   "dut.testbed",
+	mpb.Metadata_TESTBED_DUT_DUT_4LINKS:        "dutdut.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_2LINKS:        "atedut_2.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_4LINKS:        "atedut_4.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_5LINKS:        "atedut_5.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_9LINKS_LAG:    "atedut_9_lag.testbed",
+	mpb.Metadata_TESTBED_DUT_DUT_ATE_2LINKS:    "dutdutate.testbed",
+	mpb.Metadata_TESTBED_DUT_ATE_8LINKS:        "atedut_8.testbed",
+	mpb.Metadata_TESTBED_DUT_400ZR:             "dut_400zr.testbed",
+	mpb.Metadata_TESTBED_DUT_400ZR_PLUS:        "dut_400zr_plus.testbed",
+	mpb.Metadata_TESTBED_DUT_400ZR_100G_4LINKS: "dut_400zr_100g_4links.testbed",
+	mpb.Metadata_TESTBED_DUT_400FR_100G_4LINKS: "dut_400fr_100g_4links.testbed",
+}
+
+// TestbedFile returns the name of the file under the topologies directory that
+// describes the testbed.
+func TestbedFile(testbed mpb.Metadata_Testbed) (string, error) {
+	file, ok := testbedFiles[testbed]
+	if !ok {
+		return "", fmt.Errorf("no testbed file for testbed %v", testbed)
+	}
+	return file, nil
+}
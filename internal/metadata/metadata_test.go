@@ -1,7 +1,10 @@
 package metadata
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
@@ -22,3 +25,65 @@ func TestInit(t *testing.T) {
 		t.Errorf("Init() got unexpected metadata diff: %s", diff)
 	}
 }
+
+func TestExpired(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name    string
+		date    string
+		want    bool
+		wantErr bool
+	}{{
+		name: "unset",
+		date: "",
+		want: false,
+	}, {
+		name: "future",
+		date: "2025-03-16",
+		want: false,
+	}, {
+		name: "today",
+		date: "2025-03-15",
+		want: false,
+	}, {
+		name: "past",
+		date: "2025-03-14",
+		want: true,
+	}, {
+		name:    "bad",
+		date:    "15/03/2025",
+		wantErr: true,
+	}}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pe := &mpb.Metadata_PlatformExceptions{ExpiryDate: c.date}
+			got, err := Expired(pe, now)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("Expired(%q) got error %v, want error %v", c.date, err, c.wantErr)
+			}
+			if got != c.want {
+				t.Errorf("Expired(%q) got %v, want %v", c.date, got, c.want)
+			}
+		})
+	}
+}
+
+func TestTestbedFile(t *testing.T) {
+	for value, name := range mpb.Metadata_Testbed_name {
+		testbed := mpb.Metadata_Testbed(value)
+		file, err := TestbedFile(testbed)
+		if testbed == mpb.Metadata_TESTBED_UNSPECIFIED {
+			if err == nil {
+				t.Errorf("TestbedFile(%s) got %q, want error", name, file)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("TestbedFile(%s) got error: %v", name, err)
+			continue
+		}
+		if _, err := os.Stat(filepath.Join("../../topologies", file)); err != nil {
+			t.Errorf("TestbedFile(%s) got %q, which cannot be read: %v", name, file, err)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package metadata
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+// Version is a software version split into numbers and letters, e.g.
+// "24.2R1-S1" into 24, 2, R, 1, S, 1.
+type Version struct {
+	parts []string
+}
+
+var (
+	versionPartRE = regexp.MustCompile(`[0-9]+|[A-Za-z]+`)
+	aristaTrainRE = regexp.MustCompile(`([0-9])[FM]$`)
+	ciscoBuildRE  = regexp.MustCompile(`([0-9])I$`)
+)
+
+// ParseVersion parses a software version of a device of the vendor, dropping
+// the vendor specific notations that do not affect the order of versions.
+func ParseVersion(vendor opb.Device_Vendor, s string) (Version, error) {
+	v := strings.TrimSpace(s)
+	v = strings.TrimPrefix(strings.TrimPrefix(v, "v"), "V")
+	switch vendor {
+	case opb.Device_ARISTA:
+		v = aristaTrainRE.ReplaceAllString(v, "$1")
+	case opb.Device_JUNIPER:
+		if strings.HasSuffix(strings.ToUpper(v), "-EVO") {
+			v = v[:len(v)-len("-EVO")]
+		}
+	case opb.Device_CISCO:
+		v = ciscoBuildRE.ReplaceAllString(v, "$1")
+	}
+	parts := versionPartRE.FindAllString(v, -1)
+	if len(parts) == 0 || !isNumber(parts[0]) {
+		return Version{}, fmt.Errorf("%v software version %q does not start with a number", vendor, s)
+	}
+	for i, p := range parts {
+		if isNumber(p) {
+			if p = strings.TrimLeft(p, "0"); p == "" {
+				p = "0"
+			}
+			parts[i] = p
+		} else {
+			parts[i] = strings.ToUpper(p)
+		}
+	}
+	return Version{parts: parts}, nil
+}
+
+func isNumber(part string) bool {
+	return part[0] >= '0' && part[0] <= '9'
+}
+
+// Compare returns -1, 0 or +1 if v is before, the same as, or after w.  Numbers
+// compare numerically, letters compare alphabetically, a number is before a
+// letter, and a version that extends the other is after it.
+func (v Version) Compare(w Version) int {
+	for i := 0; i < len(v.parts) && i < len(w.parts); i++ {
+		a, b := v.parts[i], w.parts[i]
+		an, bn := isNumber(a), isNumber(b)
+		switch {
+		case a == b:
+			continue
+		case an && !bn:
+			return -1
+		case !an && bn:
+			return +1
+		case an && len(a) != len(b):
+			// Without leading zeros, the longer number is the larger.
+			if len(a) < len(b) {
+				return -1
+			}
+			return +1
+		case a < b:
+			return -1
+		default:
+			return +1
+		}
+	}
+	switch {
+	case len(v.parts) < len(w.parts):
+		return -1
+	case len(v.parts) > len(w.parts):
+		return +1
+	}
+	return 0
+}
+
+// String returns the normalized version with its parts separated by dots.
+func (v Version) String() string {
+	return strings.Join(v.parts, ".")
+}
+
+// bound is one side of a VersionRange.
+type bound struct {
+	version   string
+	exclusive bool
+}
+
+func bounds(r *mpb.Metadata_VersionRange) (lo, hi bound) {
+	lo = bound{r.GetMinInclusive(), false}
+	if r.GetMinExclusive() != "" {
+		lo = bound{r.GetMinExclusive(), true}
+	}
+	hi = bound{r.GetMaxInclusive(), false}
+	if r.GetMaxExclusive() != "" {
+		hi = bound{r.GetMaxExclusive(), true}
+	}
+	return lo, hi
+}
+
+// CheckVersionRange returns an error if the range sets both the inclusive and
+// the exclusive bound on the same side, if a bound is not a valid version of
+// the vendor, or if no version can be in the range.
+func CheckVersionRange(vendor opb.Device_Vendor, r *mpb.Metadata_VersionRange) error {
+	if r.GetMinInclusive() != "" && r.GetMinExclusive() != "" {
+		return fmt.Errorf("version range %v sets both min_inclusive and min_exclusive", r)
+	}
+	if r.GetMaxInclusive() != "" && r.GetMaxExclusive() != "" {
+		return fmt.Errorf("version range %v sets both max_inclusive and max_exclusive", r)
+	}
+	lo, hi := bounds(r)
+	var lov, hiv Version
+	for _, b := range []struct {
+		s string
+		v *Version
+	}{{lo.version, &lov}, {hi.version, &hiv}} {
+		if b.s == "" {
+			continue
+		}
+		v, err := ParseVersion(vendor, b.s)
+		if err != nil {
+			return fmt.Errorf("version range %v: %w", r, err)
+		}
+		*b.v = v
+	}
+	if lo.version != "" && hi.version != "" {
+		if c := lov.Compare(hiv); c > 0 || c == 0 && (lo.exclusive || hi.exclusive) {
+			return fmt.Errorf("version range %v is empty", r)
+		}
+	}
+	return nil
+}
+
+// InVersionRange returns whether the software version of a device of the
+// vendor is in the range.  Every version is in a nil or empty range.
+func InVersionRange(vendor opb.Device_Vendor, r *mpb.Metadata_VersionRange, version string) (bool, error) {
+	if err := CheckVersionRange(vendor, r); err != nil {
+		return false, err
+	}
+	lo, hi := bounds(r)
+	if lo.version == "" && hi.version == "" {
+		return true, nil
+	}
+	v, err := ParseVersion(vendor, version)
+	if err != nil {
+		return false, err
+	}
+	if lo.version != "" {
+		lov, _ := ParseVersion(vendor, lo.version) // Checked above.
+		if c := v.Compare(lov); c < 0 || c == 0 && lo.exclusive {
+			return false, nil
+		}
+	}
+	if hi.version != "" {
+		hiv, _ := ParseVersion(vendor, hi.version) // Checked above.
+		if c := v.Compare(hiv); c > 0 || c == 0 && hi.exclusive {
+			return false, nil
+		}
+	}
+	return true, nil
+}
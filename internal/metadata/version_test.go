@@ -0,0 +1,132 @@
+package metadata
+
+import (
+	"testing"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestVersionCompare(t *testing.T) {
+	cases := []struct {
+		vendor opb.Device_Vendor
+		a, b   string
+		want   int
+	}{
+		{opb.Device_JUNIPER, "24.2R1", "24.2R1", 0},
+		{opb.Device_JUNIPER, "23.4R2-S2", "24.2R1", -1},
+		{opb.Device_JUNIPER, "24.2R1-S1", "24.2R1", +1},
+		{opb.Device_JUNIPER, "24.2R1.10-EVO", "24.2R1", +1},
+		{opb.Device_JUNIPER, "24.2R1-EVO", "24.2R1", 0},
+		{opb.Device_JUNIPER, "24.2R10", "24.2R9", +1},
+		{opb.Device_JUNIPER, "24.2X1", "24.2R1", +1},
+		{opb.Device_ARISTA, "4.30.1F", "4.30.1", 0},
+		{opb.Device_ARISTA, "4.30.10F", "4.30.9M", +1},
+		{opb.Device_ARISTA, "4.9.0F", "4.10.0F", -1},
+		{opb.Device_CISCO, "24.2.1.32I", "24.2.1.32", 0},
+		{opb.Device_CISCO, "7.11.1", "24.1.1", -1},
+		{opb.Device_NOKIA, "v23.10.1", "23.10.1", 0},
+		{opb.Device_NOKIA, "23.10.01", "23.10.1", 0},
+		{opb.Device_NOKIA, "23.10.1", "23.10.1.0", -1},
+		{opb.Device_NOKIA, "23.10.1", "23.10.R1", -1},
+	}
+	for _, c := range cases {
+		a, err := ParseVersion(c.vendor, c.a)
+		if err != nil {
+			t.Fatalf("ParseVersion(%v, %q) got error: %v", c.vendor, c.a, err)
+		}
+		b, err := ParseVersion(c.vendor, c.b)
+		if err != nil {
+			t.Fatalf("ParseVersion(%v, %q) got error: %v", c.vendor, c.b, err)
+		}
+		if got := a.Compare(b); got != c.want {
+			t.Errorf("%v: %q.Compare(%q) got %d, want %d", c.vendor, c.a, c.b, got, c.want)
+		}
+		if got := b.Compare(a); got != -c.want {
+			t.Errorf("%v: %q.Compare(%q) got %d, want %d", c.vendor, c.b, c.a, got, -c.want)
+		}
+	}
+}
+
+func TestParseVersionError(t *testing.T) {
+	for _, s := range []string{"", "-EVO", "R1.2"} {
+		if _, err := ParseVersion(opb.Device_JUNIPER, s); err == nil {
+			t.Errorf("ParseVersion(%q) got no error, want error", s)
+		}
+	}
+}
+
+func TestInVersionRange(t *testing.T) {
+	cases := []struct {
+		desc    string
+		r       *mpb.Metadata_VersionRange
+		version string
+		want    bool
+	}{{
+		desc:    "Nil",
+		version: "22.1R1",
+		want:    true,
+	}, {
+		desc:    "MaxExclusiveBelow",
+		r:       &mpb.Metadata_VersionRange{MaxExclusive: "24.2R1"},
+		version: "23.4R2-S2-EVO",
+		want:    true,
+	}, {
+		desc:    "MaxExclusiveEqual",
+		r:       &mpb.Metadata_VersionRange{MaxExclusive: "24.2R1"},
+		version: "24.2R1-EVO",
+	}, {
+		desc:    "MaxInclusiveEqual",
+		r:       &mpb.Metadata_VersionRange{MaxInclusive: "24.2R1"},
+		version: "24.2R1",
+		want:    true,
+	}, {
+		desc:    "MinInclusiveEqual",
+		r:       &mpb.Metadata_VersionRange{MinInclusive: "23.4R1", MaxExclusive: "24.2R1"},
+		version: "23.4R1",
+		want:    true,
+	}, {
+		desc:    "MinExclusiveEqual",
+		r:       &mpb.Metadata_VersionRange{MinExclusive: "23.4R1", MaxExclusive: "24.2R1"},
+		version: "23.4R1",
+	}, {
+		desc:    "Above",
+		r:       &mpb.Metadata_VersionRange{MinInclusive: "23.4R1", MaxExclusive: "24.2R1"},
+		version: "24.4R1",
+	}}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			got, err := InVersionRange(opb.Device_JUNIPER, c.r, c.version)
+			if err != nil {
+				t.Fatalf("InVersionRange(%v, %q) got error: %v", c.r, c.version, err)
+			}
+			if got != c.want {
+				t.Errorf("InVersionRange(%v, %q) got %v, want %v", c.r, c.version, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCheckVersionRange(t *testing.T) {
+	cases := []struct {
+		desc    string
+		r       *mpb.Metadata_VersionRange
+		wantErr bool
+	}{
+		{"Nil", nil, false},
+		{"Point", &mpb.Metadata_VersionRange{MinInclusive: "4.30.1F", MaxInclusive: "4.30.1F"}, false},
+		{"BothMin", &mpb.Metadata_VersionRange{MinInclusive: "4.30.1F", MinExclusive: "4.30.1F"}, true},
+		{"BothMax", &mpb.Metadata_VersionRange{MaxInclusive: "4.30.1F", MaxExclusive: "4.30.1F"}, true},
+		{"BadVersion", &mpb.Metadata_VersionRange{MaxExclusive: "latest"}, true},
+		{"Reversed", &mpb.Metadata_VersionRange{MinInclusive: "4.31.0F", MaxExclusive: "4.30.1F"}, true},
+		{"EmptyPoint", &mpb.Metadata_VersionRange{MinInclusive: "4.30.1F", MaxExclusive: "4.30.1F"}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			err := CheckVersionRange(opb.Device_ARISTA, c.r)
+			if gotErr := err != nil; gotErr != c.wantErr {
+				t.Errorf("CheckVersionRange(%v) got error %v, want error %v", c.r, err, c.wantErr)
+			}
+		})
+	}
+}
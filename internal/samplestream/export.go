@@ -0,0 +1,95 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samplestream
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openconfig/featureprofiles/internal/fptest"
+)
+
+// CSV returns the series as CSV, with a header and a row of the path,
+// timestamp and value of every sample.
+func CSV[T any](series []*Series[T]) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write([]string{"path", "timestamp", "value"})
+	for _, s := range series {
+		for _, sample := range s.Samples {
+			w.Write([]string{s.Path, sample.Timestamp.UTC().Format(time.RFC3339Nano), fmt.Sprint(sample.Value)})
+		}
+	}
+	w.Flush()
+	return b.String()
+}
+
+// labelEscaper escapes the value of an OpenMetrics label.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// OpenMetrics returns the series in the OpenMetrics text format, as a metric
+// of the name with a "path" label for each series, and the timestamp of every
+// sample.  Characters that are not allowed in metric names are replaced by
+// underscores.
+func OpenMetrics[T Number](name string, series []*Series[T]) string {
+	name = metricName(name)
+	var b strings.Builder
+	fmt.Fprintf(&b, "# TYPE %s unknown\n", name)
+	for _, s := range series {
+		for _, sample := range s.Samples {
+			fmt.Fprintf(&b, "%s{path=\"%s\"} %v %s\n", name, labelEscaper.Replace(s.Path), sample.Value, metricTimestamp(sample.Timestamp))
+		}
+	}
+	b.WriteString("# EOF\n")
+	return b.String()
+}
+
+// metricName replaces the characters that are not allowed in an OpenMetrics
+// metric name by underscores.
+func metricName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_', r == ':':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// metricTimestamp formats a time as OpenMetrics timestamp, in seconds since
+// the epoch.
+func metricTimestamp(t time.Time) string {
+	ts := fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+	return strings.TrimSuffix(strings.TrimRight(ts, "0"), ".")
+}
+
+// WriteCSV writes the series as CSV to a test output file, and returns the
+// filename relative to --outputs_dir.
+func WriteCSV[T any](filename string, series []*Series[T]) (string, error) {
+	return fptest.WriteOutput(filename, ".csv", CSV(series))
+}
+
+// WriteOpenMetrics writes the series in the OpenMetrics text format, as a
+// metric of the name, to a test output file, and returns the filename relative
+// to --outputs_dir.
+func WriteOpenMetrics[T Number](filename, name string, series []*Series[T]) (string, error) {
+	return fptest.WriteOutput(filename, ".txt", OpenMetrics(name, series))
+}
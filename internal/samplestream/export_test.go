@@ -0,0 +1,64 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samplestream
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCSV(t *testing.T) {
+	s := series([]float64{0, 1.5}, 10, 20)
+	s.Path = `/a[name="x,y"]/b`
+	want := `path,timestamp,value
+"/a[name=""x,y""]/b",2023-11-14T22:13:20Z,10
+"/a[name=""x,y""]/b",2023-11-14T22:13:21.5Z,20
+`
+	if diff := cmp.Diff(want, CSV([]*Series[int]{s})); diff != "" {
+		t.Errorf("CSV() got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestOpenMetrics(t *testing.T) {
+	s1 := series[float64]([]float64{0, 1.5}, 10, 20)
+	s2 := series([]float64{0.25}, 2.5)
+	s2.Path = `/a[name="x"]`
+	want := `# TYPE in_pkts unknown
+in_pkts{path="/interfaces/interface[name=port1]/state/counters/in-pkts"} 10 1700000000
+in_pkts{path="/interfaces/interface[name=port1]/state/counters/in-pkts"} 20 1700000001.5
+in_pkts{path="/a[name=\"x\"]"} 2.5 1700000000.25
+# EOF
+`
+	if diff := cmp.Diff(want, OpenMetrics("in-pkts", []*Series[float64]{s1, s2})); diff != "" {
+		t.Errorf("OpenMetrics() got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestMetricName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "in_pkts", want: "in_pkts"},
+		{name: "qos:queue/dropped-pkts", want: "qos:queue_dropped_pkts"},
+		{name: "1m_rate", want: "_m_rate"},
+	}
+	for _, tt := range tests {
+		if got := metricName(tt.name); got != tt.want {
+			t.Errorf("metricName(%q) got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,4 +1,9 @@
 // Package samplestream provides utilities for creating gNMI Subscriptions in SAMPLE mode.
+//
+// A SampleStream returns the last sample of a singleton query.  A Recorder
+// records the time series of every path of a singleton or wildcard query, of
+// which the counter rates, statistics and missed samples can be computed, and
+// which can be written to test outputs as CSV or OpenMetrics text.
 package samplestream
 
 import (
@@ -0,0 +1,197 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samplestream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// Sample is a value of a path at a time.
+type Sample[T any] struct {
+	Timestamp time.Time
+	Value     T
+}
+
+// Series is the samples of a path, in the order they were received.
+type Series[T any] struct {
+	Path    string
+	Samples []Sample[T]
+}
+
+// Recorder records the samples of every path matching a query, from a gNMI
+// Subscription with SAMPLE mode.  Unlike SampleStream, the query may be a
+// wildcard, and the samples keep the timestamps reported by the DUT, so that
+// the statistics of the series can be computed.
+type Recorder[T any] struct {
+	mu       sync.Mutex            // Protects series and err.
+	series   map[string]*Series[T] // Series by path.
+	err      error                 // Error that ended the subscription before it was closed.
+	cancel   context.CancelFunc    // Cancels the subscription.
+	done     chan struct{}         // Closed when the subscription has ended.
+	interval time.Duration         // Configured interval for the SAMPLE mode stream.
+}
+
+// NewRecorder subscribes to the singleton or wildcard query with SAMPLE mode
+// at the interval, and records the samples until the recorder is closed.
+func NewRecorder[T any](t *testing.T, dut *ondatra.DUTDevice, q ygnmi.AnyQuery[T], interval time.Duration) *Recorder[T] {
+	t.Helper()
+	c, err := ygnmi.NewClient(dut.RawAPIs().GNMI(t), ygnmi.WithTarget(dut.ID()))
+	if err != nil {
+		t.Fatalf("unable to connect to gNMI on %s: %v", dut.ID(), err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &Recorder[T]{
+		series:   make(map[string]*Series[T]),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		interval: interval,
+	}
+	opts := []ygnmi.Option{ygnmi.WithSubscriptionMode(gpb.SubscriptionMode_SAMPLE), ygnmi.WithSampleInterval(interval)}
+	var w *ygnmi.Watcher[T]
+	switch q := q.(type) {
+	case ygnmi.SingletonQuery[T]:
+		w = ygnmi.Watch(ctx, c, q, r.record, opts...)
+	case ygnmi.WildcardQuery[T]:
+		w = ygnmi.WatchAll(ctx, c, q, r.record, opts...)
+	default:
+		cancel()
+		t.Fatalf("query %T is neither a singleton nor a wildcard query", q)
+	}
+	go func() {
+		defer close(r.done)
+		_, err := w.Await()
+		if ctx.Err() != nil {
+			// The recorder was closed.
+			return
+		}
+		r.mu.Lock()
+		r.err = err
+		r.mu.Unlock()
+	}()
+	return r
+}
+
+func (r *Recorder[T]) record(v *ygnmi.Value[T]) error {
+	val, ok := v.Val()
+	if !ok {
+		return ygnmi.Continue
+	}
+	path, err := ygot.PathToString(v.Path)
+	if err != nil {
+		return err
+	}
+	ts := v.Timestamp
+	if ts.IsZero() {
+		ts = v.RecvTimestamp
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.series[path]
+	if !ok {
+		s = &Series[T]{Path: path}
+		r.series[path] = s
+	}
+	s.Samples = append(s.Samples, Sample[T]{Timestamp: ts, Value: val})
+	return ygnmi.Continue
+}
+
+// Interval returns the configured interval of the SAMPLE mode stream.
+func (r *Recorder[T]) Interval() time.Duration {
+	return r.interval
+}
+
+// Series returns a copy of the series recorded thus far, sorted by path.
+func (r *Recorder[T]) Series() []*Series[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var series []*Series[T]
+	for _, s := range r.series {
+		series = append(series, &Series[T]{Path: s.Path, Samples: slices.Clone(s.Samples)})
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Path < series[j].Path })
+	return series
+}
+
+// Await waits until every series has at least count samples, or until the
+// time for count samples and a tolerance has passed, and returns the series.
+// It returns the series recorded thus far with the error if the subscription
+// ended.
+func (r *Recorder[T]) Await(count int) ([]*Series[T], error) {
+	deadline := time.Now().Add(time.Duration(count)*r.interval + intervalTolerance)
+	poll := min(r.interval, intervalTolerance)
+	if poll <= 0 {
+		poll = intervalTolerance
+	}
+	for {
+		series := r.Series()
+		if time.Now().After(deadline) || complete(series, count) {
+			return series, nil
+		}
+		select {
+		case <-r.done:
+			return r.Series(), r.subscriptionErr()
+		case <-time.After(poll):
+		}
+	}
+}
+
+func complete[T any](series []*Series[T], count int) bool {
+	if len(series) == 0 {
+		return false
+	}
+	for _, s := range series {
+		if len(s.Samples) < count {
+			return false
+		}
+	}
+	return true
+}
+
+// subscriptionErr returns the error that ended the subscription, or an error
+// if it ended without one.
+func (r *Recorder[T]) subscriptionErr() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return fmt.Errorf("subscription ended: %w", r.err)
+	}
+	return errors.New("subscription ended")
+}
+
+// Close closes the gNMI subscription, and returns the error that ended it if
+// it ended before it was closed.
+func (r *Recorder[T]) Close() error {
+	r.cancel()
+	<-r.done
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return fmt.Errorf("subscription ended: %w", r.err)
+	}
+	return nil
+}
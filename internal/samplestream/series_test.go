@@ -0,0 +1,61 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samplestream
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/featureprofiles/topologies/binding/fakebind"
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/gnmi"
+)
+
+func TestRecorder(t *testing.T) {
+	duts := fakebind.Setup(t, nil)
+	dut := ondatra.DUT(t, "dut")
+	fakebind.SetState(t, duts["dut"], gnmi.OC().Interface("Ethernet1").Counters().InPkts().State(), 100)
+	fakebind.SetState(t, duts["dut"], gnmi.OC().Interface("Ethernet2").Counters().InPkts().State(), 200)
+
+	r := NewRecorder(t, dut, gnmi.OC().InterfaceAny().Counters().InPkts().State(), 100*time.Millisecond)
+	series, err := r.Await(3)
+	if err != nil {
+		t.Fatalf("Await() got error: %v", err)
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close() got error: %v", err)
+	}
+
+	want := map[string]uint64{
+		"/interfaces/interface[name=Ethernet1]/state/counters/in-pkts": 100,
+		"/interfaces/interface[name=Ethernet2]/state/counters/in-pkts": 200,
+	}
+	got := make(map[string]uint64)
+	for _, s := range series {
+		if len(s.Samples) < 3 {
+			t.Errorf("Await() got %d samples of %s, want at least 3", len(s.Samples), s.Path)
+		}
+		for _, sample := range s.Samples {
+			if sample.Value != want[s.Path] {
+				t.Errorf("Await() got sample %d of %s, want %d", sample.Value, s.Path, want[s.Path])
+			}
+		}
+		got[s.Path] = s.Samples[len(s.Samples)-1].Value
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Await() got unexpected diff of last samples (-want,+got):\n%s", diff)
+	}
+}
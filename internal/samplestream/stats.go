@@ -0,0 +1,159 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samplestream
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+// Number is a type of value whose statistics can be computed.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Counter is a type of value of a counter, which wraps past its maximum value.
+type Counter interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Delta is the increase of a counter between two consecutive samples.
+type Delta struct {
+	Start time.Time
+	End   time.Time
+	Value uint64
+	// Wrapped is whether the counter wrapped past its maximum value.
+	Wrapped bool
+	// Reset is whether the counter was reset, for example by a reboot, in
+	// which case Value is the value of the counter after the reset.
+	Reset bool
+}
+
+// Rate returns the increase per second, or 0 if the samples have the same
+// timestamp.
+func (d Delta) Rate() float64 {
+	sec := d.End.Sub(d.Start).Seconds()
+	if sec <= 0 {
+		return 0
+	}
+	return float64(d.Value) / sec
+}
+
+// Deltas returns the increases of the counter between consecutive samples.
+// A decrease of the counter is taken as a wrap if the increase across the
+// wrap is less than half the range of the counter, and as a reset otherwise.
+func Deltas[T Counter](s *Series[T]) []Delta {
+	var deltas []Delta
+	for i := 1; i < len(s.Samples); i++ {
+		prev, cur := s.Samples[i-1], s.Samples[i]
+		d := Delta{Start: prev.Timestamp, End: cur.Timestamp}
+		switch wrapped := cur.Value - prev.Value; {
+		case cur.Value >= prev.Value:
+			d.Value = uint64(cur.Value - prev.Value)
+		case wrapped < ^T(0)/2:
+			d.Value, d.Wrapped = uint64(wrapped), true
+		default:
+			d.Value, d.Reset = uint64(cur.Value), true
+		}
+		deltas = append(deltas, d)
+	}
+	return deltas
+}
+
+// Rate returns the average increase per second of the counter over the
+// series, accounting for wraps and resets, or 0 if the series spans no time.
+func Rate[T Counter](s *Series[T]) float64 {
+	if len(s.Samples) < 2 {
+		return 0
+	}
+	var total uint64
+	for _, d := range Deltas(s) {
+		total += d.Value
+	}
+	return Delta{Start: s.Samples[0].Timestamp, End: s.Samples[len(s.Samples)-1].Timestamp, Value: total}.Rate()
+}
+
+// Summary is the statistics of the values of a series.  The statistics of an
+// empty series are NaN.
+type Summary struct {
+	Count int
+	Min   float64
+	Max   float64
+	Mean  float64
+}
+
+// Summarize returns the statistics of the values of the series.
+func Summarize[T Number](s *Series[T]) Summary {
+	if len(s.Samples) == 0 {
+		return Summary{Min: math.NaN(), Max: math.NaN(), Mean: math.NaN()}
+	}
+	sum := Summary{Count: len(s.Samples), Min: math.Inf(1), Max: math.Inf(-1)}
+	var total float64
+	for _, sample := range s.Samples {
+		v := float64(sample.Value)
+		sum.Min = min(sum.Min, v)
+		sum.Max = max(sum.Max, v)
+		total += v
+	}
+	sum.Mean = total / float64(sum.Count)
+	return sum
+}
+
+// Percentile returns the p-th percentile of the values of the series, for p
+// from 0 to 100, by the nearest-rank method.  It returns NaN if the series is
+// empty.
+func Percentile[T Number](s *Series[T], p float64) float64 {
+	if len(s.Samples) == 0 {
+		return math.NaN()
+	}
+	var vals []float64
+	for _, sample := range s.Samples {
+		vals = append(vals, float64(sample.Value))
+	}
+	slices.Sort(vals)
+	rank := int(math.Ceil(p / 100 * float64(len(vals))))
+	return vals[min(max(rank, 1), len(vals))-1]
+}
+
+// Gap is a time between consecutive samples of a series in which samples
+// were missed.
+type Gap struct {
+	Start  time.Time
+	End    time.Time
+	Missed int
+}
+
+// Gaps returns the gaps of the series, between consecutive samples more than
+// the interval and a tolerance of a second apart.  It returns no gaps if the
+// interval is not positive, as no samples are then expected.
+func Gaps[T any](s *Series[T], interval time.Duration) []Gap {
+	if interval <= 0 {
+		return nil
+	}
+	var gaps []Gap
+	for i := 1; i < len(s.Samples); i++ {
+		start, end := s.Samples[i-1].Timestamp, s.Samples[i].Timestamp
+		elapsed := end.Sub(start)
+		if elapsed <= interval+intervalTolerance {
+			continue
+		}
+		missed := int(math.Round(float64(elapsed)/float64(interval))) - 1
+		gaps = append(gaps, Gap{Start: start, End: end, Missed: max(missed, 1)})
+	}
+	return gaps
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package samplestream
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+var start = time.Unix(1700000000, 0)
+
+// series returns a series of the values sampled at the offsets in seconds
+// from start.
+func series[T any](offsets []float64, vals ...T) *Series[T] {
+	s := &Series[T]{Path: "/interfaces/interface[name=port1]/state/counters/in-pkts"}
+	for i, v := range vals {
+		ts := start.Add(time.Duration(offsets[i] * float64(time.Second)))
+		s.Samples = append(s.Samples, Sample[T]{Timestamp: ts, Value: v})
+	}
+	return s
+}
+
+func at(sec float64) time.Time {
+	return start.Add(time.Duration(sec * float64(time.Second)))
+}
+
+func TestDeltas(t *testing.T) {
+	tests := []struct {
+		desc string
+		s    *Series[uint64]
+		want []Delta
+	}{{
+		desc: "increasing",
+		s:    series[uint64]([]float64{0, 10, 20}, 100, 600, 600),
+		want: []Delta{
+			{Start: at(0), End: at(10), Value: 500},
+			{Start: at(10), End: at(20), Value: 0},
+		},
+	}, {
+		desc: "wrap",
+		s:    series[uint64]([]float64{0, 10}, math.MaxUint64-9, 5),
+		want: []Delta{{Start: at(0), End: at(10), Value: 15, Wrapped: true}},
+	}, {
+		desc: "reset",
+		s:    series[uint64]([]float64{0, 10}, 1000, 5),
+		want: []Delta{{Start: at(0), End: at(10), Value: 5, Reset: true}},
+	}, {
+		desc: "single sample",
+		s:    series([]float64{0}, uint64(1)),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, Deltas(tt.s)); diff != "" {
+				t.Errorf("Deltas() got unexpected diff (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeltas_Uint32(t *testing.T) {
+	s := series([]float64{0, 10}, uint32(math.MaxUint32), 99)
+	want := []Delta{{Start: at(0), End: at(10), Value: 100, Wrapped: true}}
+	if diff := cmp.Diff(want, Deltas(s)); diff != "" {
+		t.Errorf("Deltas() got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		desc string
+		s    *Series[uint64]
+		want float64
+	}{{
+		desc: "increasing",
+		s:    series[uint64]([]float64{0, 10, 20}, 0, 1000, 3000),
+		want: 150,
+	}, {
+		desc: "reset",
+		s:    series[uint64]([]float64{0, 5, 10}, 1000, 1500, 500),
+		want: 100,
+	}, {
+		desc: "same timestamp",
+		s:    series[uint64]([]float64{0, 0}, 0, 1000),
+		want: 0,
+	}, {
+		desc: "empty",
+		s:    &Series[uint64]{},
+		want: 0,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := Rate(tt.s); got != tt.want {
+				t.Errorf("Rate() got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	got := Summarize(series([]float64{0, 1, 2, 3}, -2.5, 10, 4, 0.5))
+	want := Summary{Count: 4, Min: -2.5, Max: 10, Mean: 3}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Summarize() got unexpected diff (-want,+got):\n%s", diff)
+	}
+	got = Summarize(&Series[int]{})
+	want = Summary{Min: math.NaN(), Max: math.NaN(), Mean: math.NaN()}
+	if diff := cmp.Diff(want, got, cmpopts.EquateNaNs()); diff != "" {
+		t.Errorf("Summarize() of empty series got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	s := series([]float64{0, 1, 2, 3, 4}, 15, 20, 35, 40, 50)
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{p: 0, want: 15},
+		{p: 5, want: 15},
+		{p: 30, want: 20},
+		{p: 40, want: 20},
+		{p: 50, want: 35},
+		{p: 100, want: 50},
+	}
+	for _, tt := range tests {
+		if got := Percentile(s, tt.p); got != tt.want {
+			t.Errorf("Percentile(%v) got %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := Percentile(&Series[int]{}, 50); !math.IsNaN(got) {
+		t.Errorf("Percentile() of empty series got %v, want NaN", got)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	s := series([]float64{0, 10, 20.5, 40, 51.5, 100}, 0, 0, 0, 0, 0, 0)
+	want := []Gap{
+		{Start: at(20.5), End: at(40), Missed: 1},
+		{Start: at(40), End: at(51.5), Missed: 1},
+		{Start: at(51.5), End: at(100), Missed: 4},
+	}
+	if diff := cmp.Diff(want, Gaps(s, 10*time.Second)); diff != "" {
+		t.Errorf("Gaps() got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestGaps_NoInterval(t *testing.T) {
+	s := series([]float64{0, 10}, 0, 0)
+	if got := Gaps(s, 0); got != nil {
+		t.Errorf("Gaps() with no interval got %v, want none", got)
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/openconfig/featureprofiles/internal/metadata"
 	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
 	"github.com/openconfig/featureprofiles/tools/internal/fpciutil"
 	"google.golang.org/protobuf/proto"
@@ -102,6 +104,22 @@ func (tc *testcase) check() []error {
 				"bad UUID in metadata: %s: got variant %s version %d; want variant RFC4122 version 4",
 				testUUID, u.Variant(), u.Version()))
 		}
+		for _, pe := range tc.existing.GetPlatformExceptions() {
+			expired, err := metadata.Expired(pe, time.Now())
+			switch {
+			case err != nil:
+				errs = append(errs, fmt.Errorf(
+					"platform_exceptions for %v: %w", pe.GetPlatform(), err))
+			case expired:
+				errs = append(errs, fmt.Errorf(
+					"platform_exceptions for %v expired on %s; remove the deviations or extend expiry_date (owner: %q, tracking issue: %q)",
+					pe.GetPlatform(), pe.GetExpiryDate(), pe.GetOwner(), pe.GetTrackingIssue()))
+			}
+			if err := metadata.CheckVersionRange(pe.GetPlatform().GetVendor(), pe.GetPlatform().GetSoftwareVersionRange()); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"platform_exceptions for %v: %w", pe.GetPlatform(), err))
+			}
+		}
 	}
 
 	return errs
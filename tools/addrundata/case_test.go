@@ -9,6 +9,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
 	"google.golang.org/protobuf/testing/protocmp"
+
+	opb "github.com/openconfig/ondatra/proto"
 )
 
 const (
@@ -135,6 +137,57 @@ func TestCase_Check(t *testing.T) {
 			},
 		},
 		want: 4,
+	}, {
+		name: "expired",
+		tc: testcase{
+			markdown: &mpb.Metadata{
+				PlanId:      "XX-1.1",
+				Description: "Foo Functional Test",
+			},
+			existing: &mpb.Metadata{
+				Uuid:        "123e4567-e89b-42d3-8456-426614174000",
+				PlanId:      "XX-1.1",
+				Description: "Foo Functional Test",
+				Testbed:     mpb.Metadata_TESTBED_DUT_ATE_4LINKS,
+				PlatformExceptions: []*mpb.Metadata_PlatformExceptions{{
+					Platform:   &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA},
+					ExpiryDate: "2020-01-01",
+				}, {
+					Platform:   &mpb.Metadata_Platform{Vendor: opb.Device_CISCO},
+					ExpiryDate: "2999-01-01",
+				}, {
+					Platform:   &mpb.Metadata_Platform{Vendor: opb.Device_JUNIPER},
+					ExpiryDate: "January 1st",
+				}},
+			},
+		},
+		want: 2,
+	}, {
+		name: "badversionrange",
+		tc: testcase{
+			markdown: &mpb.Metadata{
+				PlanId:      "XX-1.1",
+				Description: "Foo Functional Test",
+			},
+			existing: &mpb.Metadata{
+				Uuid:        "123e4567-e89b-42d3-8456-426614174000",
+				PlanId:      "XX-1.1",
+				Description: "Foo Functional Test",
+				Testbed:     mpb.Metadata_TESTBED_DUT_ATE_4LINKS,
+				PlatformExceptions: []*mpb.Metadata_PlatformExceptions{{
+					Platform: &mpb.Metadata_Platform{
+						Vendor:               opb.Device_JUNIPER,
+						SoftwareVersionRange: &mpb.Metadata_VersionRange{MaxExclusive: "24.2R1"},
+					},
+				}, {
+					Platform: &mpb.Metadata_Platform{
+						Vendor:               opb.Device_ARISTA,
+						SoftwareVersionRange: &mpb.Metadata_VersionRange{MinInclusive: "next"},
+					},
+				}},
+			},
+		},
+		want: 1,
 	}, {
 		name: "noexisting",
 		tc: testcase{
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// deviationMatrix maps a vendor and a deviation to the sorted test directories, relative
+// to the repository root, whose metadata sets that deviation for that vendor.
+type deviationMatrix map[string]map[string][]string
+
+// newDeviationMatrix pivots the platform exceptions of the existing metadata in the
+// testsuite into a deviation matrix.
+func newDeviationMatrix(featuredir string, ts testsuite) deviationMatrix {
+	rootdir := filepath.Dir(featuredir)
+	sets := make(map[string]map[string]map[string]bool)
+	for testdir, tc := range ts {
+		reldir, err := filepath.Rel(rootdir, testdir)
+		if err != nil {
+			reldir = testdir
+		}
+		for _, pe := range tc.existing.GetPlatformExceptions() {
+			vendor := pe.GetPlatform().GetVendor().String()
+			pe.GetDeviations().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+				if sets[vendor] == nil {
+					sets[vendor] = make(map[string]map[string]bool)
+				}
+				deviation := string(fd.Name())
+				if sets[vendor][deviation] == nil {
+					sets[vendor][deviation] = make(map[string]bool)
+				}
+				sets[vendor][deviation][reldir] = true
+				return true
+			})
+		}
+	}
+
+	m := make(deviationMatrix)
+	for vendor, deviations := range sets {
+		m[vendor] = make(map[string][]string)
+		for deviation, testdirs := range deviations {
+			m[vendor][deviation] = sortedKeys(testdirs)
+		}
+	}
+	return m
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// vendors returns the sorted vendors of the matrix.
+func (m deviationMatrix) vendors() []string {
+	return sortedKeys(m)
+}
+
+// deviations returns the sorted deviations of the matrix across all vendors.
+func (m deviationMatrix) deviations() []string {
+	set := make(map[string]bool)
+	for _, deviations := range m {
+		for deviation := range deviations {
+			set[deviation] = true
+		}
+	}
+	return sortedKeys(set)
+}
+
+// listDeviationsCSV writes the deviation matrix as CSV, with the columns "Vendor",
+// "Deviation", "Count" and "Test Paths", where the test paths are separated by spaces.
+func listDeviationsCSV(w io.Writer, featuredir string, ts testsuite) error {
+	m := newDeviationMatrix(featuredir, ts)
+
+	cw := csv.NewWriter(w)
+	heading := []string{"Vendor", "Deviation", "Count", "Test Paths"}
+	if err := cw.Write(heading); err != nil {
+		return err
+	}
+	for _, vendor := range m.vendors() {
+		for _, deviation := range sortedKeys(m[vendor]) {
+			testdirs := m[vendor][deviation]
+			row := []string{vendor, deviation, strconv.Itoa(len(testdirs)), strings.Join(testdirs, " ")}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// listDeviationsJSON writes the deviation matrix as a JSON map from vendor to deviation
+// to the count and the test package directories.
+//
+// Example:
+//
+//	{
+//	  "ARISTA": {
+//	    "omit_l2_mtu": {
+//	      "count": 2,
+//	      "tests": [
+//	        "feature/foo/otg_tests/bar_test",
+//	        "feature/foo/otg_tests/baz_test"
+//	      ]
+//	    }
+//	  },
+//	  ...
+//	}
+func listDeviationsJSON(w io.Writer, featuredir string, ts testsuite) error {
+	m := newDeviationMatrix(featuredir, ts)
+
+	o := make(map[string]map[string]jsonDeviation)
+	for vendor, deviations := range m {
+		o[vendor] = make(map[string]jsonDeviation)
+		for deviation, testdirs := range deviations {
+			o[vendor][deviation] = jsonDeviation{Count: len(testdirs), Tests: testdirs}
+		}
+	}
+	data, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = w.Write(data)
+	return err
+}
+
+type jsonDeviation struct {
+	Count int      `json:"count"`
+	Tests []string `json:"tests"`
+}
+
+var deviationsHTML = template.Must(template.New("deviations").Funcs(template.FuncMap{
+	"join": strings.Join,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Deviations by vendor</title>
+<style>
+table { border-collapse: collapse; font-family: sans-serif; font-size: small; }
+th, td { border: 1px solid #ccc; padding: 2px 6px; }
+th { background: #eee; position: sticky; top: 0; }
+td.count { text-align: right; }
+td.count[title] { background: #fdd; }
+</style>
+</head>
+<body>
+<h1>Deviations by vendor</h1>
+<p>Number of tests whose metadata.textproto sets the deviation for the vendor.  Hover over a count to see the tests.</p>
+<table>
+<tr><th>Deviation</th>{{range .Vendors}}<th>{{.}}</th>{{end}}<th>Total</th></tr>
+{{- range .Rows}}
+<tr><td>{{.Deviation}}</td>{{range .Cells}}<td class="count"{{with .}} title="{{join . "\n"}}"{{end}}>{{with .}}{{len .}}{{end}}</td>{{end}}<td class="count">{{.Total}}</td></tr>
+{{- end}}
+<tr><th>Total</th>{{range .Totals}}<th>{{.}}</th>{{end}}<th></th></tr>
+</table>
+</body>
+</html>
+`))
+
+type htmlRow struct {
+	Deviation string
+	Cells     [][]string // Test directories for each vendor.
+	Total     int
+}
+
+// listDeviationsHTML writes the deviation matrix as a static HTML table with a row per
+// deviation and a column per vendor, where each cell is the number of tests.
+func listDeviationsHTML(w io.Writer, featuredir string, ts testsuite) error {
+	m := newDeviationMatrix(featuredir, ts)
+	vendors := m.vendors()
+	totals := make([]int, len(vendors))
+
+	var rows []htmlRow
+	for _, deviation := range m.deviations() {
+		row := htmlRow{Deviation: deviation}
+		for i, vendor := range vendors {
+			testdirs := m[vendor][deviation]
+			row.Cells = append(row.Cells, testdirs)
+			row.Total += len(testdirs)
+			totals[i] += len(testdirs)
+		}
+		rows = append(rows, row)
+	}
+
+	return deviationsHTML.Execute(w, struct {
+		Vendors []string
+		Rows    []htmlRow
+		Totals  []int
+	}{vendors, rows, totals})
+}
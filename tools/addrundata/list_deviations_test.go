@@ -0,0 +1,120 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func deviationsSuite() testsuite {
+	arista := func(d *mpb.Metadata_Deviations) *mpb.Metadata_PlatformExceptions {
+		return &mpb.Metadata_PlatformExceptions{
+			Platform:   &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA},
+			Deviations: d,
+		}
+	}
+	cisco := func(d *mpb.Metadata_Deviations) *mpb.Metadata_PlatformExceptions {
+		return &mpb.Metadata_PlatformExceptions{
+			Platform:   &mpb.Metadata_Platform{Vendor: opb.Device_CISCO},
+			Deviations: d,
+		}
+	}
+	return testsuite{
+		"/fp/feature/foo/bar/otg_tests/qux_test": &testcase{
+			existing: &mpb.Metadata{PlatformExceptions: []*mpb.Metadata_PlatformExceptions{
+				arista(&mpb.Metadata_Deviations{OmitL2Mtu: true, BannerDelimiter: "^C"}),
+				cisco(&mpb.Metadata_Deviations{OmitL2Mtu: true}),
+			}},
+		},
+		"/fp/feature/foo/baz/tests/quuz_test": &testcase{
+			existing: &mpb.Metadata{PlatformExceptions: []*mpb.Metadata_PlatformExceptions{
+				arista(&mpb.Metadata_Deviations{OmitL2Mtu: true}),
+				// A second exception for the same vendor counts the test once.
+				{
+					Platform:   &mpb.Metadata_Platform{Vendor: opb.Device_ARISTA, HardwareModelRegex: "^7280"},
+					Deviations: &mpb.Metadata_Deviations{OmitL2Mtu: true},
+				},
+			}},
+		},
+		"/fp/feature/foo/baz/tests/corge_test": &testcase{
+			existing: &mpb.Metadata{},
+		},
+	}
+}
+
+func TestNewDeviationMatrix(t *testing.T) {
+	got := newDeviationMatrix("/fp/feature", deviationsSuite())
+	want := deviationMatrix{
+		"ARISTA": {
+			"banner_delimiter": {"feature/foo/bar/otg_tests/qux_test"},
+			"omit_l2_mtu":      {"feature/foo/bar/otg_tests/qux_test", "feature/foo/baz/tests/quuz_test"},
+		},
+		"CISCO": {
+			"omit_l2_mtu": {"feature/foo/bar/otg_tests/qux_test"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("newDeviationMatrix -want,+got:\n%s", diff)
+	}
+}
+
+func TestListDeviationsCSV(t *testing.T) {
+	const want = `Vendor,Deviation,Count,Test Paths
+ARISTA,banner_delimiter,1,feature/foo/bar/otg_tests/qux_test
+ARISTA,omit_l2_mtu,2,feature/foo/bar/otg_tests/qux_test feature/foo/baz/tests/quuz_test
+CISCO,omit_l2_mtu,1,feature/foo/bar/otg_tests/qux_test
+`
+	var buf strings.Builder
+	if err := listDeviationsCSV(&buf, "/fp/feature", deviationsSuite()); err != nil {
+		t.Fatal("Could not write CSV:", err)
+	}
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("listDeviationsCSV -want,+got:\n%s", diff)
+	}
+}
+
+func TestListDeviationsJSON(t *testing.T) {
+	var buf strings.Builder
+	if err := listDeviationsJSON(&buf, "/fp/feature", deviationsSuite()); err != nil {
+		t.Fatal("Could not write JSON:", err)
+	}
+	var got map[string]map[string]jsonDeviation
+	if err := json.Unmarshal([]byte(buf.String()), &got); err != nil {
+		t.Fatalf("Could not parse JSON: %v", err)
+	}
+	want := map[string]map[string]jsonDeviation{
+		"ARISTA": {
+			"banner_delimiter": {Count: 1, Tests: []string{"feature/foo/bar/otg_tests/qux_test"}},
+			"omit_l2_mtu":      {Count: 2, Tests: []string{"feature/foo/bar/otg_tests/qux_test", "feature/foo/baz/tests/quuz_test"}},
+		},
+		"CISCO": {
+			"omit_l2_mtu": {Count: 1, Tests: []string{"feature/foo/bar/otg_tests/qux_test"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("listDeviationsJSON -want,+got:\n%s", diff)
+	}
+}
+
+func TestListDeviationsHTML(t *testing.T) {
+	var buf strings.Builder
+	if err := listDeviationsHTML(&buf, "/fp/feature", deviationsSuite()); err != nil {
+		t.Fatal("Could not write HTML:", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"<tr><th>Deviation</th><th>ARISTA</th><th>CISCO</th><th>Total</th></tr>",
+		`<tr><td>banner_delimiter</td><td class="count" title="feature/foo/bar/otg_tests/qux_test">1</td><td class="count"></td><td class="count">1</td></tr>`,
+		"<tr><td>omit_l2_mtu</td><td class=\"count\" title=\"feature/foo/bar/otg_tests/qux_test\nfeature/foo/baz/tests/quuz_test\">2</td>",
+		"<tr><th>Total</th><th>3</th><th>1</th><th></th></tr>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("listDeviationsHTML got:\n%s\nwant it to contain:\n%s", got, want)
+		}
+	}
+}
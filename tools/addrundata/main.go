@@ -11,11 +11,16 @@
 // Test plan ID and the description are extracted from the README.md, whereas the UUID is
 // randomly assigned.  Existing UUID assignments are honored.  ATE and OTG versions of the
 // same test must have the same UUID.
+//
+// The testbed of each test is also checked against the topologies directory: it must map
+// to an existing .testbed file, the ports referenced by the test code through calls like
+// dut.Port(t, "port1") must exist in that testbed, and a .binding file must cover it.
 package main
 
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"flag"
 
@@ -26,8 +31,9 @@ import (
 var (
 	dir       = flag.String("dir", "", "Directory to search for tests; if not specified, uses the ancestor 'feature' directory.")
 	fix       = flag.Bool("fix", false, "Update the rundata in tests.  If false, only check if the tests have the most recent rundata.")
-	list      = flag.String("list", "", "List the tests in one of the following formats: csv, json")
+	list      = flag.String("list", "", "List the tests in one of the following formats: csv, json, testtracker; or list the deviations set by the tests for each vendor in one of: deviations-csv, deviations-json, deviations-html")
 	mergejson = flag.String("mergejson", "", "Merge the JSON listing from this JSON file.")
+	topodir   = flag.String("topologies", "", "Directory of the testbed and binding files to check the tests against; if not specified, uses the 'topologies' directory next to the feature root.")
 )
 
 func main() {
@@ -65,13 +71,47 @@ func main() {
 			glog.Exitf("Error writing TestTracker: %v", err)
 		}
 		return
+	case "deviations-csv":
+		if err := listDeviationsCSV(os.Stdout, featuredir, ts); err != nil {
+			glog.Exitf("Error writing deviations CSV: %v", err)
+		}
+		return
+	case "deviations-json":
+		if err := listDeviationsJSON(os.Stdout, featuredir, ts); err != nil {
+			glog.Exitf("Error writing deviations JSON: %v", err)
+		}
+		return
+	case "deviations-html":
+		if err := listDeviationsHTML(os.Stdout, featuredir, ts); err != nil {
+			glog.Exitf("Error writing deviations HTML: %v", err)
+		}
+		return
 	default:
 		glog.Exitf("Unknown listing format: %s", *list)
 	}
 
+	if *topodir == "" {
+		*topodir = filepath.Join(filepath.Dir(featuredir), "topologies")
+	}
+	topo, err := readTopology(*topodir)
+	if err != nil {
+		glog.Exitf("Unable to read topologies: %v", err)
+	}
+
+	// The testbeds are checked apart from the rundata, as --fix cannot repair them.
+	topoOK := ts.checkTopology(featuredir, topo)()
+	const topoProblems = "Testbed check found problems, which --fix does not repair.  Please correct the testbed in metadata.textproto or the ports in the test code, or add a binding to %s."
+
 	if !*fix {
-		if ok := ts.check(featuredir); !ok {
-			glog.Exitf("Rundata check found problems.  Please run: go run ./tools/addrundata --fix")
+		ok := ts.check(featuredir)
+		if !ok {
+			glog.Errorf("Rundata check found problems.  Please run: go run ./tools/addrundata --fix")
+		}
+		if !topoOK {
+			glog.Errorf(topoProblems, *topodir)
+		}
+		if !ok || !topoOK {
+			glog.Exit("Check failed.")
 		}
 		return
 	}
@@ -91,4 +131,7 @@ func main() {
 	default:
 		glog.Exitf("Failed to update rundata: %v", err)
 	}
+	if !topoOK {
+		glog.Exitf(topoProblems, *topodir)
+	}
 }
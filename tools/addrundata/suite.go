@@ -147,6 +147,45 @@ func (ts testsuite) checkCases(featuredir string) func() bool {
 	return fn
 }
 
+// checkTopology returns a function that checks the testbed of each test case and the
+// ports referenced by the test code against the topology.
+func (ts testsuite) checkTopology(featuredir string, topo *topology) func() bool {
+	fn := func() (ok bool) {
+		ok = true
+		if topo == nil {
+			return ok
+		}
+
+		for testdir, tc := range ts {
+			if tc.existing == nil {
+				continue
+			}
+			var errs []error
+			if refs, err := readPortRefs(testdir); err != nil {
+				errs = append(errs, fmt.Errorf("could not read the test code: %w", err))
+			} else {
+				errs = topo.check(tc.existing, refs)
+			}
+			if len(errs) == 0 {
+				continue
+			}
+			ok = false
+			reldir, err := filepath.Rel(filepath.Dir(featuredir), testdir)
+			if err != nil {
+				reldir = testdir
+			}
+			errorf("Found %d testbed errors in %s", len(errs), reldir)
+			for _, err := range errs {
+				errorf("  - %v", err)
+			}
+		}
+
+		return ok
+	}
+
+	return fn
+}
+
 // checkDuplicate returns a function that checks for duplicate assignments except for
 // between ATE and OTG tests of the same test.  The keying is determined by keyfn.
 func (ts testsuite) checkDuplicate(what string, keyfn func(tc *testcase) string) func() bool {
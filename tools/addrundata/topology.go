@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/featureprofiles/internal/metadata"
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// topology holds the testbeds and bindings found in the topologies directory.
+type topology struct {
+	testbeds map[string]*opb.Testbed    // Keyed by file name.
+	bindings map[string]*bindpb.Binding // Keyed by file name.
+}
+
+// readTopology reads the *.testbed and *.binding files in the topologies
+// directory.
+func readTopology(dir string) (*topology, error) {
+	topo := &topology{
+		testbeds: make(map[string]*opb.Testbed),
+		bindings: make(map[string]*bindpb.Binding),
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() {
+			continue
+		}
+		var err error
+		switch filepath.Ext(name) {
+		case ".testbed":
+			tb := new(opb.Testbed)
+			err = readTextproto(filepath.Join(dir, name), tb)
+			topo.testbeds[name] = tb
+		case ".binding":
+			b := new(bindpb.Binding)
+			err = readTextproto(filepath.Join(dir, name), b)
+			topo.bindings[name] = b
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return topo, nil
+}
+
+func readTextproto(filename string, m proto.Message) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	if err := prototext.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("could not parse %s: %w", filename, err)
+	}
+	return nil
+}
+
+// portRef is a port referenced by the test code, e.g. dut.Port(t, "port1").
+type portRef struct {
+	device string // Device ID, or empty if it could not be determined.
+	port   string
+}
+
+func (r portRef) String() string {
+	if r.device == "" {
+		return r.port
+	}
+	return r.device + ":" + r.port
+}
+
+// deviceID returns the device ID if expr is a call like ondatra.DUT(t, "dut")
+// or ondatra.ATE(t, "ate").
+func deviceID(expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "DUT" && sel.Sel.Name != "ATE") {
+		return "", false
+	}
+	return stringLit(call.Args[1])
+}
+
+func stringLit(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// readPortRefs returns the sorted, unique ports referenced by the Go files in
+// testdir through calls like dut.Port(t, "port1").  The device is determined
+// if the receiver is a variable assigned from ondatra.DUT or ondatra.ATE in the
+// same file.  Ports named by anything other than a string literal are ignored.
+func readPortRefs(testdir string) ([]portRef, error) {
+	files, err := filepath.Glob(filepath.Join(testdir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	refs := make(map[portRef]bool)
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		devices := make(map[string]string)
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				for i, rhs := range n.Rhs {
+					if id, ok := deviceID(rhs); ok && i < len(n.Lhs) {
+						if lhs, ok := n.Lhs[i].(*ast.Ident); ok {
+							devices[lhs.Name] = id
+						}
+					}
+				}
+			case *ast.ValueSpec:
+				for i, v := range n.Values {
+					if id, ok := deviceID(v); ok && i < len(n.Names) {
+						devices[n.Names[i].Name] = id
+					}
+				}
+			}
+			return true
+		})
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Port" {
+				return true
+			}
+			port, ok := stringLit(call.Args[1])
+			if !ok {
+				return true
+			}
+			ref := portRef{port: port}
+			if id, ok := deviceID(sel.X); ok {
+				ref.device = id
+			} else if x, ok := sel.X.(*ast.Ident); ok {
+				ref.device = devices[x.Name]
+			}
+			refs[ref] = true
+			return true
+		})
+	}
+	sorted := make([]portRef, 0, len(refs))
+	for ref := range refs {
+		sorted = append(sorted, ref)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].device != sorted[j].device {
+			return sorted[i].device < sorted[j].device
+		}
+		return sorted[i].port < sorted[j].port
+	})
+	return sorted, nil
+}
+
+// testbedPorts returns the port IDs of each device in the testbed.
+func testbedPorts(tb *opb.Testbed) map[string]map[string]bool {
+	devices := make(map[string]map[string]bool)
+	for _, d := range append(append([]*opb.Device{}, tb.GetDuts()...), tb.GetAtes()...) {
+		ports := make(map[string]bool)
+		for _, p := range d.GetPorts() {
+			ports[p.GetId()] = true
+		}
+		devices[d.GetId()] = ports
+	}
+	return devices
+}
+
+// covers returns whether the binding has every device and port of the testbed.
+func covers(b *bindpb.Binding, tb *opb.Testbed) bool {
+	bound := make(map[string]map[string]bool)
+	for _, d := range append(append([]*bindpb.Device{}, b.GetDuts()...), b.GetAtes()...) {
+		ports := make(map[string]bool)
+		for _, p := range d.GetPorts() {
+			ports[p.GetId()] = true
+		}
+		bound[d.GetId()] = ports
+	}
+	for id, ports := range testbedPorts(tb) {
+		bports, ok := bound[id]
+		if !ok {
+			return false
+		}
+		for port := range ports {
+			if !bports[port] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// check verifies that the testbed of the metadata has a testbed file, that the
+// ports referenced by the test exist in it, and that a binding covers it.
+func (topo *topology) check(md *mpb.Metadata, refs []portRef) []error {
+	if md.GetTestbed() == mpb.Metadata_TESTBED_UNSPECIFIED {
+		return nil // Reported by testcase.check().
+	}
+	file, err := metadata.TestbedFile(md.GetTestbed())
+	if err != nil {
+		return []error{err}
+	}
+	tb, ok := topo.testbeds[file]
+	if !ok {
+		return []error{fmt.Errorf("testbed %v maps to topologies/%s, which does not exist", md.GetTestbed(), file)}
+	}
+
+	var errs []error
+	devices := testbedPorts(tb)
+	for _, ref := range refs {
+		if ref.device != "" {
+			ports, ok := devices[ref.device]
+			switch {
+			case !ok:
+				errs = append(errs, fmt.Errorf("test references device %q, which is not in topologies/%s", ref.device, file))
+			case !ports[ref.port]:
+				errs = append(errs, fmt.Errorf("test references port %s, which is not in topologies/%s", ref, file))
+			}
+			continue
+		}
+		found := false
+		for _, ports := range devices {
+			found = found || ports[ref.port]
+		}
+		if !found {
+			errs = append(errs, fmt.Errorf("test references port %s, which is not on any device in topologies/%s", ref, file))
+		}
+	}
+
+	var covering []string
+	for name, b := range topo.bindings {
+		if covers(b, tb) {
+			covering = append(covering, name)
+		}
+	}
+	if len(covering) == 0 {
+		var names []string
+		for name := range topo.bindings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		errs = append(errs, fmt.Errorf("topologies/%s is not covered by any binding in topologies: %s", file, strings.Join(names, ", ")))
+	}
+	return errs
+}
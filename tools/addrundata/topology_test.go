@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+const (
+	atedut2Testbed = `
+duts {
+  id: "dut"
+  ports { id: "port1" }
+  ports { id: "port2" }
+}
+ates {
+  id: "ate"
+  ports { id: "port1" }
+  ports { id: "port2" }
+}
+`
+	atedut2Binding = `
+duts {
+  id: "dut"
+  ports { id: "port1" name: "Ethernet1/1" }
+  ports { id: "port2" name: "Ethernet2/1" }
+}
+ates {
+  id: "ate"
+  ports { id: "port1" name: "1/1" }
+  ports { id: "port2" name: "1/2" }
+}
+`
+	dutdutTestbed = `
+duts {
+  id: "dut1"
+  ports { id: "port1" }
+}
+duts {
+  id: "dut2"
+  ports { id: "port1" }
+}
+`
+	portsTestGo = `package foo_test
+
+import "github.com/openconfig/ondatra"
+
+var ate = ondatra.ATE(t, "ate")
+
+func TestFoo(t *testing.T) {
+	dut := ondatra.DUT(t, "dut")
+	_ = dut.Port(t, "port1")
+	_ = ate.Port(t, "port2")
+	_ = ondatra.DUT(t, "dut").Port(t, "port1")
+	_ = helper(t).Port(t, "port3")
+	_ = dut.Port(t, portName)
+}
+`
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadPortRefs(t *testing.T) {
+	testdir := t.TempDir()
+	writeFiles(t, testdir, map[string]string{"foo_test.go": portsTestGo})
+	got, err := readPortRefs(testdir)
+	if err != nil {
+		t.Fatalf("readPortRefs() got error: %v", err)
+	}
+	want := []portRef{
+		{port: "port3"},
+		{device: "ate", port: "port2"},
+		{device: "dut", port: "port1"},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(portRef{})); diff != "" {
+		t.Errorf("readPortRefs() -want,+got:\n%s", diff)
+	}
+}
+
+func TestTopology_Check(t *testing.T) {
+	topodir := t.TempDir()
+	writeFiles(t, topodir, map[string]string{
+		"atedut_2.testbed": atedut2Testbed,
+		"atedut_2.binding": atedut2Binding,
+		"dutdut.testbed":   dutdutTestbed,
+	})
+	topo, err := readTopology(topodir)
+	if err != nil {
+		t.Fatalf("readTopology() got error: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		testbed mpb.Metadata_Testbed
+		refs    []portRef
+		want    []string // Substrings of the errors.
+	}{{
+		name:    "Good",
+		testbed: mpb.Metadata_TESTBED_DUT_ATE_2LINKS,
+		refs:    []portRef{{device: "dut", port: "port1"}, {port: "port2"}},
+	}, {
+		name:    "Unspecified",
+		testbed: mpb.Metadata_TESTBED_UNSPECIFIED,
+	}, {
+		name:    "MissingTestbedFile",
+		testbed: mpb.Metadata_TESTBED_DUT_ATE_4LINKS,
+		want:    []string{"atedut_4.testbed, which does not exist"},
+	}, {
+		name:    "UnknownDevice",
+		testbed: mpb.Metadata_TESTBED_DUT_ATE_2LINKS,
+		refs:    []portRef{{device: "dut2", port: "port1"}},
+		want:    []string{`device "dut2"`},
+	}, {
+		name:    "UnknownPort",
+		testbed: mpb.Metadata_TESTBED_DUT_ATE_2LINKS,
+		refs:    []portRef{{device: "ate", port: "port3"}, {port: "port4"}},
+		want:    []string{"port ate:port3", "port port4"},
+	}, {
+		name:    "NotCovered",
+		testbed: mpb.Metadata_TESTBED_DUT_DUT_4LINKS,
+		refs:    []portRef{{device: "dut2", port: "port1"}},
+		want:    []string{"dutdut.testbed is not covered by any binding"},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errs := topo.check(&mpb.Metadata{Testbed: c.testbed}, c.refs)
+			if len(errs) != len(c.want) {
+				t.Fatalf("check() got errors %v, want %d errors", errs, len(c.want))
+			}
+			for i, err := range errs {
+				if !strings.Contains(err.Error(), c.want[i]) {
+					t.Errorf("check() got error %q, want it to contain %q", err, c.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSuite_CheckTopology(t *testing.T) {
+	topodir := t.TempDir()
+	writeFiles(t, topodir, map[string]string{
+		"atedut_2.testbed": atedut2Testbed,
+		"atedut_2.binding": atedut2Binding,
+	})
+	topo, err := readTopology(topodir)
+	if err != nil {
+		t.Fatalf("readTopology() got error: %v", err)
+	}
+	featuredir := t.TempDir()
+	testdir := filepath.Join(featuredir, "foo/bar/otg_tests/qux_test")
+	if err := os.MkdirAll(testdir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, testdir, map[string]string{"qux_test.go": `package qux_test
+
+func TestQux(t *testing.T) {
+	dut := ondatra.DUT(t, "dut")
+	_ = dut.Port(t, "port2")
+}
+`})
+
+	for _, c := range []struct {
+		testbed mpb.Metadata_Testbed
+		ok      bool
+	}{
+		{mpb.Metadata_TESTBED_DUT_ATE_2LINKS, true},
+		{mpb.Metadata_TESTBED_DUT_ATE_4LINKS, false},
+	} {
+		ts := testsuite{testdir: &testcase{existing: &mpb.Metadata{Testbed: c.testbed}}}
+		if got := ts.checkTopology(featuredir, topo)(); got != c.ok {
+			t.Errorf("checkTopology() for %v got ok %v, want %v", c.testbed, got, c.ok)
+		}
+	}
+	ts := testsuite{testdir: &testcase{existing: &mpb.Metadata{Testbed: mpb.Metadata_TESTBED_DUT_ATE_4LINKS}}}
+	if !ts.checkTopology(featuredir, nil)() {
+		t.Errorf("checkTopology() without topology got ok false, want true")
+	}
+}
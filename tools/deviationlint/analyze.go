@@ -0,0 +1,355 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	mpb "github.com/openconfig/featureprofiles/proto/metadata_go_proto"
+)
+
+const (
+	modulePath     = "github.com/openconfig/featureprofiles"
+	deviationsPath = modulePath + "/internal/deviations"
+)
+
+// getterFields maps the Go getter names of the Deviations message to the proto
+// field names, e.g. "GetOmitL2Mtu" to "omit_l2_mtu".
+func getterFields() map[string]string {
+	m := make(map[string]string)
+	rt := reflect.TypeOf(mpb.Metadata_Deviations{})
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		tag, ok := sf.Tag.Lookup("protobuf")
+		if !ok {
+			continue
+		}
+		for _, part := range strings.Split(tag, ",") {
+			if name, ok := strings.CutPrefix(part, "name="); ok {
+				m["Get"+sf.Name] = name
+			}
+		}
+	}
+	return m
+}
+
+// isAccessor returns whether fn is a deviation accessor, which is an exported
+// function taking an *ondatra.DUTDevice or *ondatra.ATEDevice.
+func isAccessor(fn *ast.FuncDecl) bool {
+	if fn.Recv != nil || !fn.Name.IsExported() || fn.Type.Params.NumFields() == 0 {
+		return false
+	}
+	star, ok := fn.Type.Params.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	return sel.Sel.Name == "DUTDevice" || sel.Sel.Name == "ATEDevice"
+}
+
+// readAccessors parses the deviations package in dir and maps each accessor to
+// the sorted Deviations proto fields that it reads.
+func readAccessors(dir string) (map[string][]string, error) {
+	getters := getterFields()
+	fset := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	accessors := make(map[string][]string)
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !isAccessor(fn) {
+				continue
+			}
+			fields := map[string]bool{}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+					if field, ok := getters[sel.Sel.Name]; ok {
+						fields[field] = true
+					}
+				}
+				return true
+			})
+			accessors[fn.Name.Name] = sortedKeys(fields)
+		}
+	}
+	return accessors, nil
+}
+
+// goPackage summarizes a Go package directory for the purpose of this analysis.
+type goPackage struct {
+	// imports are the directories of the packages imported from this module.
+	imports map[string]bool
+	// uses are the deviation accessors referenced by the package.
+	uses map[string]bool
+}
+
+// readPackages parses all Go files, including tests, under root and returns
+// the packages keyed by their directory.
+func readPackages(root string) (map[string]*goPackage, error) {
+	pkgs := make(map[string]*goPackage)
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name := d.Name(); p != root && (strings.HasPrefix(name, ".") || name == "testdata" || name == "protobuf-import" || name == "openconfig_public") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(p, ".go") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, p, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Dir(p)
+		pkg := pkgs[dir]
+		if pkg == nil {
+			pkg = &goPackage{imports: map[string]bool{}, uses: map[string]bool{}}
+			pkgs[dir] = pkg
+		}
+		addFile(root, pkg, f)
+		return nil
+	})
+	return pkgs, err
+}
+
+// addFile adds the module imports and accessor references of f to pkg.
+func addFile(root string, pkg *goPackage, f *ast.File) {
+	devName := ""
+	for _, imp := range f.Imports {
+		ipath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		if rel, ok := strings.CutPrefix(ipath, modulePath+"/"); ok {
+			pkg.imports[filepath.Join(root, filepath.FromSlash(rel))] = true
+		}
+		if ipath == deviationsPath {
+			devName = path.Base(ipath)
+			if imp.Name != nil {
+				devName = imp.Name.Name
+			}
+		}
+	}
+	if devName == "" || devName == "_" {
+		return
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if id, ok := sel.X.(*ast.Ident); ok && id.Name == devName {
+			pkg.uses[sel.Sel.Name] = true
+		}
+		return true
+	})
+}
+
+// reachableUses returns the accessors referenced by the package in dir and all
+// packages of this module that it imports transitively.
+func reachableUses(pkgs map[string]*goPackage, dir string) map[string]bool {
+	uses := map[string]bool{}
+	seen := map[string]bool{}
+	var visit func(string)
+	visit = func(dir string) {
+		if seen[dir] {
+			return
+		}
+		seen[dir] = true
+		pkg := pkgs[dir]
+		if pkg == nil {
+			return
+		}
+		for a := range pkg.uses {
+			uses[a] = true
+		}
+		for imp := range pkg.imports {
+			visit(imp)
+		}
+	}
+	visit(dir)
+	return uses
+}
+
+// unreadDeviation is a deviation set in a test's metadata.textproto that is
+// not read by the test code.
+type unreadDeviation struct {
+	TestDir string
+	Vendor  string
+	Field   string
+}
+
+// report holds the findings of the analysis.
+type report struct {
+	Unread             []unreadDeviation
+	FieldsNoAccessor   []string
+	AccessorsNotCalled []string
+}
+
+// empty returns whether there are no findings.
+func (r *report) empty() bool {
+	return len(r.Unread) == 0 && len(r.FieldsNoAccessor) == 0 && len(r.AccessorsNotCalled) == 0
+}
+
+// analyze inspects the repository rooted at root.
+func analyze(root string) (*report, error) {
+	devdir := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(deviationsPath, modulePath+"/")))
+	accessors, err := readAccessors(devdir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deviation accessors: %w", err)
+	}
+	pkgs, err := readPackages(root)
+	if err != nil {
+		return nil, fmt.Errorf("could not read Go packages: %w", err)
+	}
+	r := &report{}
+
+	// Proto fields that no accessor reads.
+	read := map[string]bool{}
+	for _, fields := range accessors {
+		for _, f := range fields {
+			read[f] = true
+		}
+	}
+	fds := (&mpb.Metadata_Deviations{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		if name := string(fds.Get(i).Name()); !read[name] {
+			r.FieldsNoAccessor = append(r.FieldsNoAccessor, name)
+		}
+	}
+	sort.Strings(r.FieldsNoAccessor)
+
+	// Accessors that are never referenced outside of the deviations package.
+	called := map[string]bool{}
+	for dir, pkg := range pkgs {
+		if dir == devdir {
+			continue
+		}
+		for a := range pkg.uses {
+			called[a] = true
+		}
+	}
+	for a := range accessors {
+		if !called[a] {
+			r.AccessorsNotCalled = append(r.AccessorsNotCalled, a)
+		}
+	}
+	sort.Strings(r.AccessorsNotCalled)
+
+	// Deviations set by a test's metadata but not read by the test code.
+	featuredir := filepath.Join(root, "feature")
+	err = filepath.WalkDir(featuredir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "metadata.textproto" {
+			return err
+		}
+		md, err := readMetadata(p)
+		if err != nil {
+			return err
+		}
+		dir := filepath.Dir(p)
+		reads := map[string]bool{}
+		for a := range reachableUses(pkgs, dir) {
+			for _, f := range accessors[a] {
+				reads[f] = true
+			}
+		}
+		reldir, err := filepath.Rel(root, dir)
+		if err != nil {
+			reldir = dir
+		}
+		for _, pe := range md.GetPlatformExceptions() {
+			vendor := pe.GetPlatform().GetVendor().String()
+			pe.GetDeviations().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+				if name := string(fd.Name()); !reads[name] {
+					r.Unread = append(r.Unread, unreadDeviation{TestDir: reldir, Vendor: vendor, Field: name})
+				}
+				return true
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(r.Unread, func(i, j int) bool {
+		a, b := r.Unread[i], r.Unread[j]
+		if a.TestDir != b.TestDir {
+			return a.TestDir < b.TestDir
+		}
+		if a.Vendor != b.Vendor {
+			return a.Vendor < b.Vendor
+		}
+		return a.Field < b.Field
+	})
+	return r, nil
+}
+
+func readMetadata(p string) (*mpb.Metadata, error) {
+	bytes, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	md := new(mpb.Metadata)
+	if err := prototext.Unmarshal(bytes, md); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", p, err)
+	}
+	return md, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
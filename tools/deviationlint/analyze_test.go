@@ -0,0 +1,164 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// writeTree writes files keyed by their slash separated path relative to root.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for rel, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+const deviationsGo = `package deviations
+
+import "github.com/openconfig/ondatra"
+
+func lookupDUTDeviations(dut *ondatra.DUTDevice) *mpb.Metadata_Deviations { return nil }
+
+// OmitL2MTU is called by the helper.
+func OmitL2MTU(dut *ondatra.DUTDevice) bool {
+	return lookupDUTDeviations(dut).GetOmitL2Mtu()
+}
+
+// BannerDelimiter is called by the test.
+func BannerDelimiter(dut *ondatra.DUTDevice) string {
+	return lookupDUTDeviations(dut).GetBannerDelimiter()
+}
+
+// NeverCalled is not called by anyone.
+func NeverCalled(dut *ondatra.DUTDevice) bool {
+	return lookupDUTDeviations(dut).GetIpv4MissingEnabled()
+}
+
+// Usage is not an accessor.
+func Usage() {}
+`
+
+const helperGo = `package helper
+
+import "github.com/openconfig/featureprofiles/internal/deviations"
+
+func Configure(dut *ondatra.DUTDevice) {
+	if deviations.OmitL2MTU(dut) {
+	}
+}
+`
+
+const fooTestGo = `package foo_test
+
+import (
+	"github.com/openconfig/featureprofiles/internal/helper"
+	dev "github.com/openconfig/featureprofiles/internal/deviations"
+)
+
+func TestFoo(t *testing.T) {
+	helper.Configure(dut)
+	_ = dev.BannerDelimiter(dut)
+}
+`
+
+const fooMetadata = `
+uuid: "c857db98-7b2c-433c-b9fb-4511b42edd78"
+plan_id: "XX-1.1"
+description: "Foo"
+platform_exceptions: {
+  platform: { vendor: ARISTA }
+  deviations: {
+    omit_l2_mtu: true
+    banner_delimiter: "^C"
+    traceroute_fragmentation: true
+  }
+}
+platform_exceptions: {
+  platform: { vendor: CISCO }
+  deviations: {
+    ipv4_missing_enabled: true
+  }
+}
+`
+
+func TestAnalyze(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"internal/deviations/deviations.go":                     deviationsGo,
+		"internal/deviations/deviations_test.go":                "package deviations\n\nfunc GetTracerouteFragmentation() {}\n",
+		"internal/helper/helper.go":                             helperGo,
+		"feature/foo/bar/otg_tests/foo_test/foo_test.go":        fooTestGo,
+		"feature/foo/bar/otg_tests/foo_test/metadata.textproto": fooMetadata,
+	})
+
+	got, err := analyze(root)
+	if err != nil {
+		t.Fatalf("analyze() got error: %v", err)
+	}
+
+	testdir := filepath.FromSlash("feature/foo/bar/otg_tests/foo_test")
+	wantUnread := []unreadDeviation{
+		{TestDir: testdir, Vendor: "ARISTA", Field: "traceroute_fragmentation"},
+		{TestDir: testdir, Vendor: "CISCO", Field: "ipv4_missing_enabled"},
+	}
+	if diff := cmp.Diff(wantUnread, got.Unread); diff != "" {
+		t.Errorf("analyze() Unread -want,+got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"NeverCalled"}, got.AccessorsNotCalled); diff != "" {
+		t.Errorf("analyze() AccessorsNotCalled -want,+got:\n%s", diff)
+	}
+	for _, f := range []string{"omit_l2_mtu", "banner_delimiter", "ipv4_missing_enabled"} {
+		for _, g := range got.FieldsNoAccessor {
+			if f == g {
+				t.Errorf("analyze() FieldsNoAccessor contains %q which has an accessor", f)
+			}
+		}
+	}
+	if !strings.Contains(strings.Join(got.FieldsNoAccessor, ","), "traceroute_fragmentation") {
+		t.Errorf("analyze() FieldsNoAccessor got %v, want it to contain traceroute_fragmentation", got.FieldsNoAccessor)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	r := &report{
+		Unread:             []unreadDeviation{{TestDir: "feature/foo", Vendor: "NOKIA", Field: "omit_l2_mtu"}},
+		FieldsNoAccessor:   []string{"traceroute_fragmentation"},
+		AccessorsNotCalled: []string{"NeverCalled"},
+	}
+	var b strings.Builder
+	writeReport(&b, r)
+	want := `Deviations set in metadata.textproto but never read by the test:
+  feature/foo: NOKIA: omit_l2_mtu
+Deviations proto fields without an accessor:
+  traceroute_fragmentation
+Deviation accessors that are never called:
+  NeverCalled
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Errorf("writeReport() -want,+got:\n%s", diff)
+	}
+}
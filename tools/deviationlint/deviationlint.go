@@ -0,0 +1,88 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary deviationlint finds deviations that have drifted out of use.  It
+// parses the Go code and all feature metadata.textproto files of the
+// repository and reports:
+//
+//   - deviations set in a test's metadata.textproto that are never read by
+//     the test code, including the packages of this repository it imports;
+//   - fields of the Deviations message in proto/metadata.proto that have no
+//     accessor in internal/deviations;
+//   - accessors in internal/deviations that are never called.
+//
+// It can be run from anywhere inside the repository:
+//
+//	go run ./tools/deviationlint
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	log "github.com/golang/glog"
+	"github.com/openconfig/featureprofiles/tools/internal/fpciutil"
+)
+
+var (
+	root = flag.String("root", "", "Root directory of the featureprofiles repository; if not specified, uses the parent of the ancestor 'feature' directory.")
+)
+
+func writeReport(w io.Writer, r *report) {
+	if len(r.Unread) > 0 {
+		fmt.Fprintln(w, "Deviations set in metadata.textproto but never read by the test:")
+		for _, u := range r.Unread {
+			fmt.Fprintf(w, "  %s: %s: %s\n", u.TestDir, u.Vendor, u.Field)
+		}
+	}
+	if len(r.FieldsNoAccessor) > 0 {
+		fmt.Fprintln(w, "Deviations proto fields without an accessor:")
+		for _, f := range r.FieldsNoAccessor {
+			fmt.Fprintf(w, "  %s\n", f)
+		}
+	}
+	if len(r.AccessorsNotCalled) > 0 {
+		fmt.Fprintln(w, "Deviation accessors that are never called:")
+		for _, a := range r.AccessorsNotCalled {
+			fmt.Fprintf(w, "  %s\n", a)
+		}
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	rootdir := *root
+	if rootdir == "" {
+		featuredir, err := fpciutil.FeatureDir()
+		if err != nil {
+			log.Exitf("Unable to locate feature root: %v", err)
+		}
+		rootdir = filepath.Dir(featuredir)
+	}
+
+	r, err := analyze(rootdir)
+	if err != nil {
+		log.Exitf("Unable to analyze deviations: %v", err)
+	}
+	if r.empty() {
+		fmt.Fprintln(os.Stderr, "No deviation drift found.")
+		return
+	}
+	writeReport(os.Stdout, r)
+	os.Exit(1)
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command validate_binding checks a static binding file offline, using the
+// same resolution as the binding does when a test reserves the testbed, so
+// mistakes are found before a hardware reservation rather than after.
+//
+// It reports every problem at once: duplicate device or port IDs, services
+// without a target, TLS certificate, key and trust bundle files that are
+// missing or do not parse, reset config files that are missing or do not
+// parse, and devices, ports or dimensions of the testbed that the binding does
+// not match.
+//
+// Usage:
+//
+//	go run ./tools/validate_binding -binding topologies/atedut_4.binding -testbed topologies/atedut_4.testbed
+//
+// Without -testbed, the binding is checked against every .testbed file in the
+// -topologies directory, and the testbeds it can reserve are listed.  The
+// command fails if the binding itself has problems, if -testbed is given and
+// cannot be reserved, or if no testbed can be reserved.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/openconfig/featureprofiles/topologies/binding"
+	opb "github.com/openconfig/ondatra/proto"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// The binding package registers its own flags on the command line, including
+// -binding, so this command uses a flag set of its own.
+var (
+	flags       = flag.NewFlagSet("validate_binding", flag.ExitOnError)
+	bindingFile = flags.String("binding", "", "Binding file to validate.")
+	testbedFile = flags.String("testbed", "", "Testbed file to validate the binding against; if not specified, uses every .testbed file in -topologies.")
+	topodir     = flags.String("topologies", "topologies", "Directory of the testbed files.")
+)
+
+func main() {
+	flags.Parse(os.Args[1:])
+	if *bindingFile == "" {
+		fmt.Fprintln(os.Stderr, "-binding must be provided")
+		os.Exit(2)
+	}
+
+	testbedFiles := []string{*testbedFile}
+	if *testbedFile == "" {
+		var err error
+		testbedFiles, err = filepath.Glob(filepath.Join(*topodir, "*.testbed"))
+		if err != nil || len(testbedFiles) == 0 {
+			fmt.Fprintf(os.Stderr, "No testbed files found in %s: %v\n", *topodir, err)
+			os.Exit(2)
+		}
+	}
+
+	if !validate(context.Background(), os.Stdout, *bindingFile, testbedFiles, *testbedFile == "") {
+		os.Exit(1)
+	}
+}
+
+// validate writes the problems of the binding, then the result of reserving
+// each testbed with it.  It returns false if the binding has problems or if a
+// testbed cannot be reserved, except that when anyTestbed is set, it is enough
+// for one of the testbeds to be reserved.
+func validate(ctx context.Context, w io.Writer, bindingFile string, testbedFiles []string, anyTestbed bool) bool {
+	b, err := binding.ReadBinding(bindingFile)
+	if err != nil {
+		fmt.Fprintf(w, "%s: %v\n", bindingFile, err)
+		return false
+	}
+	ok := true
+	for _, err := range binding.Validate(b) {
+		fmt.Fprintf(w, "%s: %v\n", bindingFile, err)
+		ok = false
+	}
+
+	reserved := 0
+	for _, testbedFile := range testbedFiles {
+		tb, err := readTestbed(testbedFile)
+		if err != nil {
+			fmt.Fprintf(w, "%s: %v\n", testbedFile, err)
+			ok = false
+			continue
+		}
+		errs := binding.ValidateTestbed(ctx, b, tb)
+		if len(errs) == 0 {
+			fmt.Fprintf(w, "%s: can reserve %s\n", bindingFile, testbedFile)
+			reserved++
+			continue
+		}
+		for _, err := range errs {
+			fmt.Fprintf(w, "%s: cannot reserve %s: %v\n", bindingFile, testbedFile, err)
+		}
+		if !anyTestbed {
+			ok = false
+		}
+	}
+	if anyTestbed && reserved == 0 {
+		fmt.Fprintf(w, "%s: cannot reserve any testbed\n", bindingFile)
+		ok = false
+	}
+	return ok
+}
+
+func readTestbed(testbedFile string) (*opb.Testbed, error) {
+	data, err := os.ReadFile(testbedFile)
+	if err != nil {
+		return nil, err
+	}
+	tb := &opb.Testbed{}
+	if err := prototext.Unmarshal(data, tb); err != nil {
+		return nil, fmt.Errorf("unable to parse testbed file: %w", err)
+	}
+	return tb, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	dutBinding = `
+duts {
+  id: "dut"
+  name: "dut.example.com"
+  ports { id: "port1" name: "Ethernet1" }
+}
+`
+	dutTestbed = `
+duts {
+  id: "dut"
+  ports { id: "port1" }
+}
+`
+	dut2Testbed = `
+duts {
+  id: "dut"
+  ports { id: "port1" }
+  ports { id: "port2" }
+}
+`
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestValidate(t *testing.T) {
+	bindingFile := writeFile(t, "dut.binding", dutBinding)
+	badBindingFile := writeFile(t, "bad.binding", dutBinding+`options { trust_bundle_file: "/does/not/exist.pem" }`)
+	testbedFile := writeFile(t, "dut.testbed", dutTestbed)
+	testbed2File := writeFile(t, "dut2.testbed", dut2Testbed)
+
+	cases := []struct {
+		desc         string
+		bindingFile  string
+		testbedFiles []string
+		anyTestbed   bool
+		want         []string // Substrings of the output.
+		wantOK       bool
+	}{{
+		desc:         "Good",
+		bindingFile:  bindingFile,
+		testbedFiles: []string{testbedFile},
+		want:         []string{"can reserve " + testbedFile},
+		wantOK:       true,
+	}, {
+		desc:         "MissingPort",
+		bindingFile:  bindingFile,
+		testbedFiles: []string{testbed2File},
+		want:         []string{`cannot reserve ` + testbed2File + `: missing binding for port "port2"`},
+	}, {
+		desc:         "AnyTestbed",
+		bindingFile:  bindingFile,
+		testbedFiles: []string{testbedFile, testbed2File},
+		anyTestbed:   true,
+		want:         []string{"can reserve " + testbedFile, "cannot reserve " + testbed2File},
+		wantOK:       true,
+	}, {
+		desc:         "NoTestbed",
+		bindingFile:  bindingFile,
+		testbedFiles: []string{testbed2File},
+		anyTestbed:   true,
+		want:         []string{"cannot reserve any testbed"},
+	}, {
+		desc:         "BadBinding",
+		bindingFile:  badBindingFile,
+		testbedFiles: []string{testbedFile},
+		want:         []string{"binding options: trust_bundle_file", "can reserve " + testbedFile},
+	}, {
+		desc:         "MissingBinding",
+		bindingFile:  filepath.Join(t.TempDir(), "missing.binding"),
+		testbedFiles: []string{testbedFile},
+		want:         []string{"unable to read binding file"},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.desc, func(t *testing.T) {
+			var buf strings.Builder
+			gotOK := validate(context.Background(), &buf, c.bindingFile, c.testbedFiles, c.anyTestbed)
+			if gotOK != c.wantOK {
+				t.Errorf("validate() got ok %v, want %v; output:\n%s", gotOK, c.wantOK, buf.String())
+			}
+			for _, want := range c.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("validate() got output:\n%s\nwant it to contain: %q", buf.String(), want)
+				}
+			}
+		})
+	}
+}
@@ -23,6 +23,9 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
@@ -58,6 +61,8 @@ type staticBind struct {
 	r          resolver
 	resv       *binding.Reservation
 	pushConfig bool
+	leases     *leases   // Locks the devices if they are shared; may be nil.
+	recorder   *recorder // Records the gRPC calls to the devices; may be nil.
 }
 
 var _ binding.Binding = (*staticBind)(nil)
@@ -66,6 +71,27 @@ type staticDUT struct {
 	*binding.AbstractDUT
 	r   resolver
 	dev *bindpb.Device
+	rec *recorder
+	cli *cliConn // Created by the first DialCLI; may be nil.
+}
+
+// cliConn is the CLI client of a DUT, kept open for the next DialCLI.
+type cliConn struct {
+	mu  sync.Mutex
+	cli *cli // May be nil.
+}
+
+// cliConnsMu guards the creation of the cliConn of the DUTs.
+var cliConnsMu sync.Mutex
+
+// cliConn returns the cliConn of the DUT, creating it if needed.
+func (d *staticDUT) cliConn() *cliConn {
+	cliConnsMu.Lock()
+	defer cliConnsMu.Unlock()
+	if d.cli == nil {
+		d.cli = new(cliConn)
+	}
+	return d.cli
 }
 
 var _ introspect.Introspector = (*staticDUT)(nil)
@@ -74,6 +100,7 @@ type staticATE struct {
 	*binding.AbstractATE
 	r      resolver
 	dev    *bindpb.Device
+	rec    *recorder
 	ixweb  *ixweb.IxWeb
 	ixsess *ixweb.Session
 }
@@ -82,18 +109,37 @@ var _ introspect.Introspector = (*staticATE)(nil)
 
 const resvID = "STATIC"
 
-func (b *staticBind) Reserve(ctx context.Context, tb *opb.Testbed, runTime, waitTime time.Duration, partial map[string]string) (*binding.Reservation, error) {
+func (b *staticBind) Reserve(ctx context.Context, tb *opb.Testbed, runTime, waitTime time.Duration, partial map[string]string) (_ *binding.Reservation, rerr error) {
 	_ = runTime
-	_ = waitTime
-	_ = partial
 	if b.resv != nil {
 		return nil, fmt.Errorf("only one reservation is allowed")
 	}
-	resv, err := reservation(ctx, tb, b.r)
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, b.closeRecorder())
+		}
+	}()
+	resv, err := reservation(ctx, tb, b.r, partial)
 	if err != nil {
 		return nil, err
 	}
+	b.record(resv)
 	resv.ID = resvID
+	if b.leases != nil {
+		id, err := b.leases.reserveWait(ctx, tb, resvDevices(resv), waitTime)
+		if err != nil {
+			return nil, err
+		}
+		resv.ID = id
+		defer func() {
+			if rerr != nil {
+				if err := b.leases.release(); err != nil {
+					rerr = errors.Join(rerr, err)
+				}
+				b.resv = nil
+			}
+		}()
+	}
 	b.resv = resv
 
 	if b.pushConfig {
@@ -107,20 +153,90 @@ func (b *staticBind) Reserve(ctx context.Context, tb *opb.Testbed, runTime, wait
 	return resv, nil
 }
 
-func (b *staticBind) Release(ctx context.Context) error {
+func (b *staticBind) Release(ctx context.Context) (rerr error) {
 	if b.resv == nil {
 		return errors.New("no reservation")
 	}
+	defer func() {
+		rerr = errors.Join(rerr, b.closeRecorder())
+	}()
 	if err := b.releaseIxSessions(ctx); err != nil {
 		return err
 	}
+	if b.leases != nil {
+		if err := b.leases.release(); err != nil {
+			return err
+		}
+	}
 	b.resv = nil
 	return nil
 }
 
-func (b *staticBind) FetchReservation(_ context.Context, id string) (*binding.Reservation, error) {
-	_ = id
-	return nil, errors.New("static binding does not support fetching an existing reservation")
+// FetchReservation resumes a reservation made by another process with the
+// same binding, which is only possible if the devices are leased.
+func (b *staticBind) FetchReservation(ctx context.Context, id string) (_ *binding.Reservation, rerr error) {
+	if b.leases == nil {
+		return nil, errors.New("static binding does not support fetching an existing reservation without -binding-lease-dir")
+	}
+	if b.resv != nil {
+		return nil, fmt.Errorf("only one reservation is allowed")
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, b.closeRecorder())
+		}
+	}()
+	tb, err := b.leases.fetch(id)
+	if err != nil {
+		return nil, err
+	}
+	resv, err := reservation(ctx, tb, b.r, nil)
+	if err != nil {
+		return nil, errors.Join(err, b.leases.release())
+	}
+	b.record(resv)
+	resv.ID = id
+	b.resv = resv
+	return resv, nil
+}
+
+// closeRecorder closes the recorder of the binding, if any, so that the
+// record file is complete.
+func (b *staticBind) closeRecorder() error {
+	if b.recorder == nil {
+		return nil
+	}
+	if err := b.recorder.close(); err != nil {
+		return fmt.Errorf("could not close gRPC record file: %w", err)
+	}
+	return nil
+}
+
+// record makes the devices of the reservation record their gRPC calls, if the
+// binding has a recorder.
+func (b *staticBind) record(resv *binding.Reservation) {
+	if b.recorder == nil {
+		return
+	}
+	for _, dut := range resv.DUTs {
+		dut.(*staticDUT).rec = b.recorder
+	}
+	for _, ate := range resv.ATEs {
+		ate.(*staticATE).rec = b.recorder
+	}
+}
+
+// resvDevices returns the sorted names of the devices in the reservation.
+func resvDevices(resv *binding.Reservation) []string {
+	var names []string
+	for _, dut := range resv.DUTs {
+		names = append(names, dut.Name())
+	}
+	for _, ate := range resv.ATEs {
+		names = append(names, ate.Name())
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (b *staticBind) reset(ctx context.Context) error {
@@ -140,19 +256,11 @@ func (d *staticDUT) Dialer(svc introspect.Service) (*introspect.Dialer, error) {
 		return nil, fmt.Errorf("no known DUT service %v", svc)
 	}
 	bopts := d.r.grpc(d.dev, params)
-	return makeDialer(params, bopts)
+	return makeDialer(params, bopts, d.rec)
 }
 
 func (d *staticDUT) reset(ctx context.Context) error {
-	// Each of the individual reset functions should be no-op if the reset action is not
-	// requested.
-	if err := resetCLI(ctx, d); err != nil {
-		return err
-	}
-	if err := resetGNMI(ctx, d); err != nil {
-		return err
-	}
-	return resetGRIBI(ctx, d)
+	return runResetSteps(ctx, d, resetSteps(d.dev.GetConfig()))
 }
 
 func (d *staticDUT) DialGNMI(ctx context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
@@ -195,13 +303,30 @@ func (d *staticDUT) DialP4RT(ctx context.Context, opts ...grpc.DialOption) (p4pb
 	return p4pb.NewP4RuntimeClient(conn), nil
 }
 
-func (d *staticDUT) DialCLI(context.Context) (binding.CLIClient, error) {
+func (d *staticDUT) DialCLI(ctx context.Context) (binding.CLIClient, error) {
+	return d.dialCLI(ctx)
+}
+
+// dialCLI returns the CLI client of the DUT, which is kept open so that every
+// caller shares one SSH connection and interactive shell.  It dials the DUT
+// again if the connection was closed.
+func (d *staticDUT) dialCLI(ctx context.Context) (*cli, error) {
+	conn := d.cliConn()
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	if conn.cli != nil && !conn.cli.isClosed() {
+		return conn.cli, nil
+	}
 	sshOpts := d.r.ssh(d.dev)
+	username, password, err := readCredentials(sshOpts)
+	if err != nil {
+		return nil, err
+	}
 	c := &ssh.ClientConfig{
-		User: sshOpts.Username,
+		User: username,
 		Auth: []ssh.AuthMethod{
-			ssh.Password(sshOpts.Password),
-			ssh.KeyboardInteractive(sshInteractive(sshOpts.Password)),
+			ssh.Password(password),
+			ssh.KeyboardInteractive(sshInteractive(password)),
 		},
 	}
 	if sshOpts.SkipVerify {
@@ -213,11 +338,24 @@ func (d *staticDUT) DialCLI(context.Context) (binding.CLIClient, error) {
 		}
 		c.HostKeyCallback = cb
 	}
-	sc, err := ssh.Dial("tcp", sshOpts.Target, c)
+	target := sshOpts.Target
+	if sshOpts.Srv {
+		if target, err = lookupSRV(ctx, "ssh", target); err != nil {
+			return nil, err
+		}
+	}
+	dial, err := deviceDialer(sshOpts)
+	if err != nil {
+		return nil, err
+	}
+	sc, err := dialSSH(ctx, dial, withDefaultPort(target, 22), c)
 	if err != nil {
 		return nil, err
 	}
-	return newCLI(sc)
+	if conn.cli, err = newCLI(sc, d.dev.GetVendor()); err != nil {
+		return nil, err
+	}
+	return conn.cli, nil
 }
 
 // For every question asked in an interactive login ssh session, set the answer to user password.
@@ -237,7 +375,7 @@ func (a *staticATE) Dialer(svc introspect.Service) (*introspect.Dialer, error) {
 		return nil, fmt.Errorf("no known ATE service %v", svc)
 	}
 	bopts := a.r.grpc(a.dev, params)
-	return makeDialer(params, bopts)
+	return makeDialer(params, bopts, a.rec)
 }
 
 func (a *staticATE) DialGNMI(ctx context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
@@ -274,14 +412,59 @@ func (a *staticATE) DialIxNetwork(ctx context.Context) (*binding.IxNetwork, erro
 	return &binding.IxNetwork{Session: ixs}, nil
 }
 
-func reservation(ctx context.Context, tb *opb.Testbed, r resolver) (*binding.Reservation, error) {
+// reservation reserves the testbed with the binding.  The partial map pins
+// testbed devices and ports, by ID or "<device-id>:<port-id>", to the names of
+// binding devices and ports.
+func reservation(ctx context.Context, tb *opb.Testbed, r resolver, partial map[string]string) (*binding.Reservation, error) {
 	if r.Dynamic {
-		return dynamicReservation(ctx, tb, r)
+		return dynamicReservation(ctx, tb, r, partial)
 	}
 	resv, errs := staticReservation(tb, r)
+	errs = append(errs, checkPartial(resv, partial)...)
 	return resv, errors.Join(errs...)
 }
 
+// checkPartial checks that a static reservation, where the binding decides the
+// devices and ports, agrees with the partial mapping.
+func checkPartial(resv *binding.Reservation, partial map[string]string) []error {
+	var errs []error
+	for _, id := range sortedKeys(partial) {
+		name := partial[id]
+		devID, portID, isPort := strings.Cut(id, ":")
+		var dims *binding.Dims
+		if dut, ok := resv.DUTs[devID]; ok {
+			dims = dut.(*staticDUT).Dims
+		} else if ate, ok := resv.ATEs[devID]; ok {
+			dims = ate.(*staticATE).Dims
+		} else {
+			errs = append(errs, fmt.Errorf("partial reservation of unknown device %q", devID))
+			continue
+		}
+		bound := dims.Name
+		if isPort {
+			port, ok := dims.Ports[portID]
+			if !ok {
+				errs = append(errs, fmt.Errorf("partial reservation of unknown port %q", id))
+				continue
+			}
+			bound = port.Name
+		}
+		if bound != name {
+			errs = append(errs, fmt.Errorf("partial reservation maps %q to %q, but the binding maps it to %q", id, name, bound))
+		}
+	}
+	return errs
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func staticReservation(tb *opb.Testbed, r resolver) (*binding.Reservation, []error) {
 	var errs []error
 
@@ -437,8 +620,10 @@ func newIxWebClient(ctx context.Context, opts *bindpb.Options) (*ixweb.IxWeb, er
 		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
 	hc := &http.Client{Transport: tr}
-	username := opts.GetUsername()
-	password := opts.GetPassword()
+	username, password, err := readCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
 	if username == "" && password == "" {
 		username = "admin"
 		password = "admin"
@@ -493,10 +678,17 @@ func dialOpts(bopts *bindpb.Options) ([]grpc.DialOption, error) {
 		tlsConfig := credentials.NewTLS(tls)
 		opts = append(opts, grpc.WithTransportCredentials(tlsConfig))
 	}
-	if bopts.Username != "" {
-		c := &creds{bopts.Username, bopts.Password, !bopts.Insecure}
+	username, password, err := readCredentials(bopts)
+	if err != nil {
+		return nil, err
+	}
+	if username != "" {
+		c := &creds{username, password, !bopts.Insecure}
 		opts = append(opts, grpc.WithPerRPCCredentials(c))
 	}
+	if bopts.Token != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCreds{src: bopts.Token, secure: !bopts.Insecure}))
+	}
 	if bopts.MaxRecvMsgSize != 0 {
 		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(int(bopts.MaxRecvMsgSize))))
 	}
@@ -513,19 +705,45 @@ func dialOpts(bopts *bindpb.Options) ([]grpc.DialOption, error) {
 	return opts, nil
 }
 
-func makeDialer(params *svcParams, bopts *bindpb.Options) (*introspect.Dialer, error) {
+func makeDialer(params *svcParams, bopts *bindpb.Options, rec *recorder) (*introspect.Dialer, error) {
 	opts, err := dialOpts(bopts)
 	if err != nil {
 		return nil, err
 	}
+	if rec != nil {
+		opts = append(opts, rec.dialOpts()...)
+	}
+	if tunneled(bopts) {
+		dial, err := deviceDialer(bopts)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return dial(ctx, "tcp", addr)
+		}))
+	}
+	port := params.port
+	if bopts.Port != 0 {
+		port = int(bopts.Port)
+	}
 	return &introspect.Dialer{
-		DevicePort: params.port,
+		DevicePort: port,
 		DialFunc: func(ctx context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 			if bopts.Timeout != 0 {
 				var cancelFunc context.CancelFunc
 				_, cancelFunc = context.WithTimeout(ctx, time.Duration(bopts.Timeout)*time.Second)
 				defer cancelFunc()
 			}
+			if bopts.Srv {
+				var err error
+				if target, err = lookupSRV(ctx, params.name, target); err != nil {
+					return nil, err
+				}
+			}
+			if tunneled(bopts) && !strings.Contains(target, ":///") {
+				// The host is resolved at the other end of the tunnel.
+				target = "passthrough:///" + target
+			}
 			return grpcDialContextFn(target, opts...)
 		},
 		DialTarget: bopts.Target,
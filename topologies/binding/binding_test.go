@@ -17,6 +17,7 @@ package binding
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -53,6 +54,75 @@ func TestReserveRelease(t *testing.T) {
 	}
 }
 
+func TestReserveRelease_Recorder(t *testing.T) {
+	ctx := context.Background()
+	rec, err := newRecorder(filepath.Join(t.TempDir(), "record.pb"))
+	if err != nil {
+		t.Fatalf("newRecorder() got error: %v", err)
+	}
+	b := &staticBind{r: resolver{&bindpb.Binding{}}, recorder: rec}
+	if _, err := b.Reserve(ctx, &opb.Testbed{}, 0, 0, nil); err != nil {
+		t.Fatalf("Could not reserve testbed: %v", err)
+	}
+	if rec.f == nil {
+		t.Fatalf("Reserve() closed the record file")
+	}
+	if err := b.Release(ctx); err != nil {
+		t.Errorf("Could not release reservation: %v", err)
+	}
+	if rec.f != nil {
+		t.Errorf("Release() did not close the record file")
+	}
+
+	rec, err = newRecorder(filepath.Join(t.TempDir(), "record.pb"))
+	if err != nil {
+		t.Fatalf("newRecorder() got error: %v", err)
+	}
+	b = &staticBind{r: resolver{&bindpb.Binding{}}, recorder: rec}
+	if _, err := b.Reserve(ctx, &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}, 0, 0, nil); err == nil {
+		t.Fatalf("Reserve() of a missing DUT got no error, want error")
+	}
+	if rec.f != nil {
+		t.Errorf("Reserve() that failed did not close the record file")
+	}
+}
+
+func TestReserveFetchRelease_Leases(t *testing.T) {
+	ctx := context.Background()
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+	b := &bindpb.Binding{Duts: []*bindpb.Device{{Id: "dut", Name: "dut.name"}}}
+	dir := t.TempDir()
+	newBind := func() *staticBind {
+		return &staticBind{r: resolver{b}, leases: newLeases(dir, time.Minute)}
+	}
+
+	b1, b2 := newBind(), newBind()
+	resv, err := b1.Reserve(ctx, tb, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not reserve testbed: %v", err)
+	}
+	if resv.ID == resvID {
+		t.Errorf("Reserve() got reservation ID %q, want a unique ID", resv.ID)
+	}
+	if _, err := b2.Reserve(ctx, tb, 0, 0, nil); err == nil {
+		t.Error("Reserve() of a leased device should fail.")
+	}
+
+	fetched, err := b2.FetchReservation(ctx, resv.ID)
+	if err != nil {
+		t.Fatalf("Could not fetch reservation: %v", err)
+	}
+	if got, want := fetched.DUTs["dut"].Name(), "dut.name"; got != want {
+		t.Errorf("FetchReservation() got DUT name %q, want %q", got, want)
+	}
+	if err := b1.Release(ctx); err != nil {
+		t.Errorf("Could not release reservation: %v", err)
+	}
+	if _, err := newBind().FetchReservation(ctx, resv.ID); err == nil {
+		t.Error("FetchReservation() should fail after reservation is released.")
+	}
+}
+
 func TestStaticReservation(t *testing.T) {
 	tb := &opb.Testbed{
 		Duts: []*opb.Device{{
@@ -238,12 +308,57 @@ func TestStaticReservation_Error(t *testing.T) {
 	}
 }
 
+func TestReservation_Partial(t *testing.T) {
+	tb := &opb.Testbed{
+		Duts: []*opb.Device{{
+			Id:    "dut",
+			Ports: []*opb.Port{{Id: "port1"}},
+		}},
+	}
+	b := &bindpb.Binding{
+		Duts: []*bindpb.Device{{
+			Id:    "dut",
+			Name:  "dut.name",
+			Ports: []*bindpb.Port{{Id: "port1", Name: "Ethernet1"}},
+		}},
+	}
+
+	if _, err := reservation(context.Background(), tb, resolver{b}, map[string]string{
+		"dut":       "dut.name",
+		"dut:port1": "Ethernet1",
+	}); err != nil {
+		t.Errorf("reservation() got error for a matching partial reservation: %v", err)
+	}
+
+	_, err := reservation(context.Background(), tb, resolver{b}, map[string]string{
+		"ate":       "ate.name",
+		"dut":       "dut2.name",
+		"dut:port1": "Ethernet1",
+		"dut:port2": "Ethernet2",
+	})
+	wants := []string{
+		`partial reservation of unknown device "ate"`,
+		`partial reservation maps "dut" to "dut2.name", but the binding maps it to "dut.name"`,
+		`partial reservation of unknown port "dut:port2"`,
+	}
+	if err == nil {
+		t.Fatalf("reservation() got no error for a mismatched partial reservation, want %v", wants)
+	}
+	for _, want := range wants {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("reservation() got error %q, want error containing %q", err, want)
+		}
+	}
+}
+
 func TestDialOTGTimeout(t *testing.T) {
 	const timeoutSecs = 42
 	a := &staticATE{
 		r:   resolver{&bindpb.Binding{}},
 		dev: &bindpb.Device{Otg: &bindpb.Options{Timeout: timeoutSecs}},
 	}
+	origDialFn := grpcDialContextFn
+	defer func() { grpcDialContextFn = origDialFn }()
 	grpcDialContextFn = func(string, ...grpc.DialOption) (*grpc.ClientConn, error) {
 		return nil, nil
 	}
@@ -17,22 +17,134 @@ package binding
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/openconfig/ondatra/binding"
+	opb "github.com/openconfig/ondatra/proto"
 	"golang.org/x/crypto/ssh"
 )
 
+// ConfigCLI is implemented by the CLI clients of the static binding, to
+// configure a DUT through its CLI, e.g. as a fallback when gNMI cannot.
+type ConfigCLI interface {
+	binding.CLIClient
+	// Configure enters the configuration mode of the vendor, runs each line of
+	// the config, and commits it.  If a line or the commit fails, the config is
+	// discarded and an error is returned.  It returns the result of each
+	// non-blank line of the config that was run, in order.
+	Configure(ctx context.Context, config string) ([]binding.CommandResult, error)
+}
+
+var _ ConfigCLI = (*cli)(nil)
+
 // cli implements the binding.ClientClient interface using an SSH client.
+//
+// For the vendors in shellVendors, commands are run in one interactive shell
+// that is kept open between commands.  For other vendors, each command is run
+// in its own SSH session.
 type cli struct {
 	*binding.AbstractCLIClient
-	ssh *ssh.Client
+	ssh    *ssh.Client
+	vendor *shellVendor // May be nil.
+	closed chan struct{}
+
+	mu    sync.Mutex
+	shell *shell // Started by the first command.
+}
+
+func newCLI(sc *ssh.Client, vendor opb.Device_Vendor) (*cli, error) {
+	c := &cli{ssh: sc, vendor: shellVendors[vendor], closed: make(chan struct{})}
+	go func() {
+		sc.Wait()
+		close(c.closed)
+	}()
+	return c, nil
+}
+
+// isClosed returns whether the SSH connection is closed.
+func (c *cli) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *cli) RunCommand(ctx context.Context, cmd string) (binding.CommandResult, error) {
+	if c.vendor == nil {
+		return c.runSession(cmd)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sh, err := c.startShell(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Each line of the command is run on its own, with one combined result.
+	res := new(cmdResult)
+	var outputs []string
+	for _, line := range strings.Split(strings.TrimRight(cmd, "\n"), "\n") {
+		lineRes, err := sh.run(ctx, line)
+		if err != nil {
+			c.stopShell()
+			return nil, err
+		}
+		outputs = append(outputs, lineRes.output)
+		if res.error == "" {
+			res.error = lineRes.error
+		}
+	}
+	res.output = strings.Join(outputs, "")
+	return res, nil
+}
+
+func (c *cli) Configure(ctx context.Context, config string) ([]binding.CommandResult, error) {
+	if c.vendor == nil {
+		return nil, fmt.Errorf("configuration through the CLI is not supported for this vendor")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	sh, err := c.startShell(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := sh.configure(ctx, config)
+	var cmdResults []binding.CommandResult
+	for _, res := range results {
+		cmdResults = append(cmdResults, res)
+	}
+	if err != nil {
+		// The shell may be left in configuration mode.
+		c.stopShell()
+		return cmdResults, err
+	}
+	return cmdResults, nil
+}
+
+// startShell starts the shell if it is not running.  It must be called with mu
+// held.
+func (c *cli) startShell(ctx context.Context) (*shell, error) {
+	if c.shell == nil {
+		sh, err := newShell(ctx, c.ssh, c.vendor)
+		if err != nil {
+			return nil, err
+		}
+		c.shell = sh
+	}
+	return c.shell, nil
 }
 
-func newCLI(sc *ssh.Client) (*cli, error) {
-	return &cli{ssh: sc}, nil
+// stopShell closes the shell, to be started again by the next command.  It
+// must be called with mu held.
+func (c *cli) stopShell() {
+	c.shell.close()
+	c.shell = nil
 }
 
-func (c *cli) RunCommand(_ context.Context, cmd string) (binding.CommandResult, error) {
+// runSession runs the command in a new SSH session.
+func (c *cli) runSession(cmd string) (binding.CommandResult, error) {
 	sess, err := c.ssh.NewSession()
 	if err != nil {
 		return nil, fmt.Errorf("could not create session: %w", err)
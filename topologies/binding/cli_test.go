@@ -21,15 +21,20 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"os"
 	"strings"
+	"sync"
 	"syscall"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	opb "github.com/openconfig/ondatra/proto"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -39,6 +44,9 @@ import (
 // cli is closed.
 type cliFixture struct {
 	cli *cli
+
+	vendor opb.Device_Vendor // Vendor of the CLI client.
+	shell  func(ssh.Channel) // Runs an interactive shell, if set.
 }
 
 // serverPrivateKey and serverPublicKey are ed25519 key pairs
@@ -157,7 +165,7 @@ func (f *cliFixture) start(t testing.TB) error {
 		return fmt.Errorf("handshake errors: %v; and %v", err1, err2)
 	}
 
-	cli, err := newCLI(client)
+	cli, err := newCLI(client, f.vendor)
 	if err != nil {
 		return err
 	}
@@ -203,6 +211,10 @@ func which(b bool, yes, no string) string {
 //   - stdin: stderr hello
 //   - stderr: pty shell stderr hello
 func (f *cliFixture) handleServerChannel(c ssh.Channel, reqs <-chan *ssh.Request) {
+	if f.shell != nil {
+		f.handleShell(c, reqs)
+		return
+	}
 	var shell, pty bool
 	r := bufio.NewReader(c)
 
@@ -265,6 +277,28 @@ func (f *cliFixture) handleExec(c ssh.Channel, req *ssh.Request) {
 	c.Close()
 }
 
+// handleShell runs the interactive shell of the fixture once a shell is
+// requested, and closes the channel when it returns.  Exec requests are
+// still answered by handleExec.
+func (f *cliFixture) handleShell(c ssh.Channel, reqs <-chan *ssh.Request) {
+	for req := range reqs {
+		switch req.Type {
+		case "pty-req":
+			req.Reply(true, nil)
+		case "shell":
+			req.Reply(true, nil)
+			go func() {
+				f.shell(c)
+				c.Close()
+			}()
+		case "exec":
+			f.handleExec(c, req)
+		default:
+			req.Reply(false, nil)
+		}
+	}
+}
+
 var cmpSortStrings = cmpopts.SortSlices(func(a, b string) bool {
 	return a < b
 })
@@ -286,3 +320,208 @@ func TestCLI(t *testing.T) {
 		t.Errorf("Command output -want, +got:\n%s", diff)
 	}
 }
+
+// fakeEOS is a shell that behaves like the Arista EOS CLI: it echoes the
+// commands, starts unprivileged, paginates "show version", and keeps the config
+// committed in a configuration session.
+type fakeEOS struct {
+	mu      sync.Mutex
+	shells  int      // Number of shells started.
+	running []string // Committed config.
+}
+
+func (e *fakeEOS) run(c ssh.Channel) {
+	e.mu.Lock()
+	e.shells++
+	e.mu.Unlock()
+
+	prompt := "dut>"
+	var pending []string
+	fmt.Fprintf(c, "Last login: Wed Jan  1 00:00:00 2025\r\n%s", prompt)
+	r := bufio.NewReader(c)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		fmt.Fprintf(c, "%s\r\n", cmd)
+		switch {
+		case cmd == "enable":
+			prompt = "dut#"
+		case cmd == "terminal length 0", cmd == "terminal width 32767":
+		case cmd == "show version":
+			fmt.Fprint(c, "Arista vEOS\r\nSoftware image version: 4.30\r\n --More-- ")
+			if b, err := r.ReadByte(); err != nil || b != ' ' {
+				return
+			}
+			fmt.Fprint(c, "\r          \r\x1b[KArchitecture: x86_64\r\n")
+		case cmd == "show running-config":
+			e.mu.Lock()
+			for _, line := range e.running {
+				fmt.Fprintf(c, "%s\r\n", line)
+			}
+			e.mu.Unlock()
+		case cmd == "configure session":
+			prompt = "dut(config-s-sess1)#"
+		case cmd == "commit":
+			e.mu.Lock()
+			e.running = append(e.running, pending...)
+			e.mu.Unlock()
+			pending = nil
+			prompt = "dut#"
+		case cmd == "abort":
+			pending = nil
+			prompt = "dut#"
+		case strings.HasPrefix(cmd, "bad"):
+			fmt.Fprint(c, "% Invalid input (at token 0: 'bad')\r\n")
+		case strings.HasPrefix(prompt, "dut(config"):
+			pending = append(pending, cmd)
+		default:
+			fmt.Fprint(c, "% Invalid input\r\n")
+		}
+		fmt.Fprint(c, prompt)
+	}
+}
+
+func TestCLIShell(t *testing.T) {
+	eos := &fakeEOS{}
+	f := &cliFixture{vendor: opb.Device_ARISTA, shell: eos.run}
+	if err := f.start(t); err != nil {
+		t.Fatalf("Could not start cliFixture: %v", err)
+	}
+	ctx := context.Background()
+
+	run := func(cmd, wantOutput, wantError string) {
+		t.Helper()
+		res, err := f.cli.RunCommand(ctx, cmd)
+		if err != nil {
+			t.Fatalf("RunCommand(%q) got error: %v", cmd, err)
+		}
+		if diff := cmp.Diff(wantOutput, res.Output()); diff != "" {
+			t.Errorf("RunCommand(%q) got output diff (-want,+got):\n%s", cmd, diff)
+		}
+		if got := res.Error(); got != wantError {
+			t.Errorf("RunCommand(%q) got error %q, want %q", cmd, got, wantError)
+		}
+	}
+	run("show version", "Arista vEOS\nSoftware image version: 4.30\nArchitecture: x86_64\n", "")
+	run("bad command", "% Invalid input (at token 0: 'bad')\n", "% Invalid input (at token 0: 'bad')")
+
+	results, err := f.cli.Configure(ctx, "hostname dut1\n\nip routing\n")
+	if err != nil {
+		t.Fatalf("Configure() got error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("Configure() got %d results, want 2", len(results))
+	}
+	run("show running-config", "hostname dut1\nip routing\n", "")
+
+	results, err = f.cli.Configure(ctx, "hostname dut2\nbad line\nip multicast-routing")
+	if want := `config line 2 "bad line"`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Configure() of a bad line got error %v, want error containing %q", err, want)
+	}
+	var got []string
+	for _, res := range results {
+		got = append(got, res.Error())
+	}
+	if diff := cmp.Diff([]string{"", "% Invalid input (at token 0: 'bad')"}, got); diff != "" {
+		t.Errorf("Configure() of a bad line got result errors diff (-want,+got):\n%s", diff)
+	}
+	run("show running-config", "hostname dut1\nip routing\n", "")
+
+	// The shell is kept between commands, and started again after a failed
+	// configuration.
+	eos.mu.Lock()
+	defer eos.mu.Unlock()
+	if eos.shells != 2 {
+		t.Errorf("Got %d shells started, want 2", eos.shells)
+	}
+}
+
+func TestShellReadStopsOnClose(t *testing.T) {
+	r, w := io.Pipe()
+	defer w.Close()
+	s := &shell{out: make(chan []byte), done: make(chan struct{})}
+	exited := make(chan struct{})
+	go func() {
+		s.read(r)
+		close(exited)
+	}()
+	// The output is not received, as after a command times out.
+	if _, err := io.WriteString(w, "output"); err != nil {
+		t.Fatalf("Write() got error: %v", err)
+	}
+	close(s.done)
+	select {
+	case <-exited:
+	case <-time.After(10 * time.Second):
+		t.Fatal("read() did not return after the shell was closed")
+	}
+}
+
+func TestDisplayed(t *testing.T) {
+	tests := []struct {
+		desc, line, want string
+	}{
+		{"plain", "hello", "hello"},
+		{"crlf", "hello\r", "hello"},
+		{"overwritten", " --More-- \r          \rworld", "world     "},
+		{"escape", "\x1b[Khello\x1b[0m", "hello"},
+		{"partly overwritten", "hello\rj", "jello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			if got := displayed(tt.line); got != tt.want {
+				t.Errorf("displayed(%q) got %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResetCLI(t *testing.T) {
+	tests := []struct {
+		desc        string
+		configMode  bool
+		wantRunning []string
+		wantShells  int
+	}{{
+		desc:       "raw",
+		wantShells: 0,
+	}, {
+		desc:        "config mode",
+		configMode:  true,
+		wantRunning: []string{"hostname dut1", "ip routing"},
+		wantShells:  1,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			eos := &fakeEOS{}
+			f := &cliFixture{vendor: opb.Device_ARISTA, shell: eos.run}
+			if err := f.start(t); err != nil {
+				t.Fatalf("Could not start cliFixture: %v", err)
+			}
+			dut := &staticDUT{
+				dev: &bindpb.Device{
+					Vendor: opb.Device_ARISTA,
+					Config: &bindpb.Configs{
+						Cli:           [][]byte{[]byte("hostname dut1\nip routing")},
+						CliConfigMode: tt.configMode,
+					},
+				},
+				cli: &cliConn{cli: f.cli},
+			}
+			if err := resetCLI(context.Background(), dut); err != nil {
+				t.Fatalf("resetCLI() got error: %v", err)
+			}
+			eos.mu.Lock()
+			defer eos.mu.Unlock()
+			if diff := cmp.Diff(tt.wantRunning, eos.running); diff != "" {
+				t.Errorf("resetCLI() got running config diff (-want,+got):\n%s", diff)
+			}
+			if eos.shells != tt.wantShells {
+				t.Errorf("resetCLI() started %d shells, want %d", eos.shells, tt.wantShells)
+			}
+		})
+	}
+}
@@ -13,5 +13,11 @@
 // limitations under the License.
 
 // Package binding implements a simple binding that can work with a
-// specific hardware configuration without a reservation system.
+// specific hardware configuration without a reservation system.  A
+// reservation maps the testbed onto the devices of the binding file, and
+// when -binding-lease-dir names a directory shared by several users, it
+// leases those devices there, waiting up to -wait_time for devices leased
+// to others; another process may resume it with -reserve.  The devices are
+// dialed with the credentials and bastion options of the binding, and their
+// gRPC calls may be recorded for replay with -binding-record.
 package binding
@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
 	"github.com/openconfig/ondatra/binding"
@@ -26,24 +28,95 @@ import (
 	"github.com/pborman/uuid"
 )
 
-func dynamicReservation(ctx context.Context, tb *opb.Testbed, r resolver) (*binding.Reservation, error) {
-	abstractGraph, absNode2Dev, absPort2BindPort, err := portgraph.TestbedToAbstractGraph(tb, nil)
+// dynamicReservation solves for an assignment of the testbed to the devices
+// and links of the binding.  The partial map pins testbed devices and ports,
+// by ID or "<device-id>:<port-id>", to the names of binding devices and ports.
+func dynamicReservation(ctx context.Context, tb *opb.Testbed, r resolver, partial map[string]string) (*binding.Reservation, error) {
+	abstractGraph, absNode2Dev, absPort2BindPort, err := portgraph.TestbedToAbstractGraph(tb, partial)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse specified testbed: %w", err)
 	}
-	superGraph, conNode2Dev, conPort2BindPort, err := protoToConcreteGraph(r.Binding)
-	if err != nil {
-		return nil, fmt.Errorf("could not solve for specified testbed: %w", err)
+	var solveErr error
+	for _, b := range smallestFirst(r.Binding, tb, partial) {
+		superGraph, conNode2Dev, conPort2BindPort, err := protoToConcreteGraph(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not solve for specified testbed: %w", err)
+		}
+		assign, err := portgraph.Solve(ctx, abstractGraph, superGraph)
+		if err != nil {
+			solveErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		res, err := assignmentToReservation(assign, r, tb, absNode2Dev, conNode2Dev, absPort2BindPort, conPort2BindPort)
+		if err != nil {
+			return nil, fmt.Errorf("could not solve for specified testbed: %w", err)
+		}
+		return res, nil
 	}
-	assign, err := portgraph.Solve(ctx, abstractGraph, superGraph)
-	if err != nil {
-		return nil, fmt.Errorf("could not solve for specified testbed: %w", err)
+	return nil, fmt.Errorf("could not solve for specified testbed: %w", solveErr)
+}
+
+// smallestFirst returns the sub-bindings to solve with in turn, each with one
+// more of the DUTs and ATEs with the fewest ports, and lastly the whole binding.
+// The sub-bindings without all the devices pinned by the partial map are left
+// out, as they cannot be solved.
+//
+// This is a heuristic to leave the larger devices to other tests, and the
+// assignment is not guaranteed to use the smallest devices that satisfy the
+// testbed.  It solves once per sub-binding, so a testbed that only the whole
+// binding satisfies costs up to max(DUTs, ATEs)-1 failed solves first, each
+// of which may search all the assignments to its sub-binding.
+func smallestFirst(bpb *bindpb.Binding, tb *opb.Testbed, partial map[string]string) []*bindpb.Binding {
+	bySize := func(devs []*bindpb.Device) []*bindpb.Device {
+		devs = append([]*bindpb.Device{}, devs...)
+		sort.SliceStable(devs, func(i, j int) bool { return len(devs[i].GetPorts()) < len(devs[j].GetPorts()) })
+		return devs
 	}
-	res, err := assignmentToReservation(assign, r, tb, absNode2Dev, conNode2Dev, absPort2BindPort, conPort2BindPort)
-	if err != nil {
-		return nil, fmt.Errorf("could not solve for specified testbed: %w", err)
+	duts, ates := bySize(bpb.GetDuts()), bySize(bpb.GetAtes())
+	first := func(devs []*bindpb.Device, n, need int) []*bindpb.Device {
+		return devs[:min(len(devs), max(n, need))]
+	}
+	pinned := make(map[string]bool)
+	for id, name := range partial {
+		if !strings.Contains(id, ":") {
+			pinned[name] = true
+		}
 	}
-	return res, nil
+
+	var bs []*bindpb.Binding
+	for n := 1; n < max(len(duts), len(ates)); n++ {
+		b := &bindpb.Binding{
+			Options: bpb.GetOptions(),
+			Dynamic: bpb.GetDynamic(),
+			// The devices are shared, not cloned, so that the assignment
+			// maps to the devices of the whole binding.
+			Duts: first(duts, n, len(tb.GetDuts())),
+			Ates: first(ates, n, len(tb.GetAtes())),
+		}
+		ports := make(map[string]bool)
+		held := 0
+		for _, dev := range append(append([]*bindpb.Device{}, b.Duts...), b.Ates...) {
+			if pinned[dev.GetName()] {
+				held++
+			}
+			for _, p := range dev.GetPorts() {
+				ports[dev.GetName()+":"+p.GetName()] = true
+			}
+		}
+		if held < len(pinned) {
+			continue
+		}
+		for _, link := range bpb.GetLinks() {
+			if ports[link.GetA()] && ports[link.GetB()] {
+				b.Links = append(b.Links, link)
+			}
+		}
+		bs = append(bs, b)
+	}
+	return append(bs, bpb)
 }
 
 func protoToConcreteGraph(bpb *bindpb.Binding) (*portgraph.ConcreteGraph, map[*portgraph.ConcreteNode]*bindpb.Device, map[*portgraph.ConcretePort]*bindpb.Port, error) {
@@ -81,6 +154,9 @@ func protoToConcreteGraph(bpb *bindpb.Binding) (*portgraph.ConcreteGraph, map[*p
 		if name := dev.GetName(); name != "" {
 			node.Attrs[portgraph.NameAttr] = name
 		}
+		if vendor := dev.GetVendor(); vendor != opb.Device_VENDOR_UNSPECIFIED {
+			node.Attrs[portgraph.VendorAttr] = vendor.String()
+		}
 		if hw := dev.GetHardwareModel(); hw != "" {
 			node.Attrs[portgraph.HWAttr] = hw
 		}
@@ -83,7 +83,7 @@ func TestDynamicReservation(t *testing.T) {
 		}},
 	}
 
-	got, err := dynamicReservation(context.Background(), tb, resolver{b})
+	got, err := dynamicReservation(context.Background(), tb, resolver{b}, nil)
 	if err != nil {
 		t.Fatalf("dynamicReservation9) got unexpected error: %v", err)
 	}
@@ -209,8 +209,139 @@ func TestDynamicReservationError(t *testing.T) {
 		}},
 	}
 
-	got, err := dynamicReservation(context.Background(), tb, resolver{b})
+	got, err := dynamicReservation(context.Background(), tb, resolver{b}, nil)
 	if err == nil {
 		t.Fatalf("dynamicReservation() got unexpected success: %v", got)
 	}
 }
+
+func TestDynamicReservationChoice(t *testing.T) {
+	// Three DUTs of different sizes and vendors, all linked to one ATE.
+	b := &bindpb.Binding{
+		Dynamic: true,
+		Duts: []*bindpb.Device{{
+			Name:   "big.name",
+			Vendor: opb.Device_ARISTA,
+			Ports:  []*bindpb.Port{{Name: "Ethernet1"}, {Name: "Ethernet2"}, {Name: "Ethernet3"}, {Name: "Ethernet4"}},
+		}, {
+			Name:   "small.name",
+			Vendor: opb.Device_ARISTA,
+			Ports:  []*bindpb.Port{{Name: "Ethernet1"}, {Name: "Ethernet2"}},
+		}, {
+			Name:   "cisco.name",
+			Vendor: opb.Device_CISCO,
+			Ports:  []*bindpb.Port{{Name: "Ethernet1"}, {Name: "Ethernet2"}},
+		}},
+		Ates: []*bindpb.Device{{
+			Name:  "ate.name",
+			Ports: []*bindpb.Port{{Name: "1/1"}, {Name: "1/2"}, {Name: "1/3"}, {Name: "1/4"}, {Name: "1/5"}, {Name: "1/6"}, {Name: "1/7"}, {Name: "1/8"}},
+		}},
+		Links: []*bindpb.Link{
+			{A: "big.name:Ethernet1", B: "ate.name:1/1"},
+			{A: "big.name:Ethernet2", B: "ate.name:1/2"},
+			{A: "big.name:Ethernet3", B: "ate.name:1/3"},
+			{A: "big.name:Ethernet4", B: "ate.name:1/4"},
+			{A: "small.name:Ethernet1", B: "ate.name:1/5"},
+			{A: "small.name:Ethernet2", B: "ate.name:1/6"},
+			{A: "cisco.name:Ethernet1", B: "ate.name:1/7"},
+			{A: "cisco.name:Ethernet2", B: "ate.name:1/8"},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		vendor   opb.Device_Vendor
+		partial  map[string]string
+		wantDUT  string
+		wantPort string
+		wantErr  bool
+	}{{
+		desc:    "smallest",
+		wantDUT: "small.name",
+	}, {
+		desc:    "vendor",
+		vendor:  opb.Device_CISCO,
+		wantDUT: "cisco.name",
+	}, {
+		desc:    "partial device",
+		partial: map[string]string{"dut": "big.name"},
+		wantDUT: "big.name",
+	}, {
+		desc:     "partial port",
+		partial:  map[string]string{"dut:port1": "Ethernet2", "ate:port1": "1/8"},
+		wantDUT:  "cisco.name",
+		wantPort: "Ethernet2",
+	}, {
+		desc:    "partial device of other vendor",
+		vendor:  opb.Device_CISCO,
+		partial: map[string]string{"dut": "big.name"},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			tb := &opb.Testbed{
+				Duts: []*opb.Device{{
+					Id:     "dut",
+					Vendor: test.vendor,
+					Ports:  []*opb.Port{{Id: "port1"}},
+				}},
+				Ates: []*opb.Device{{
+					Id:    "ate",
+					Ports: []*opb.Port{{Id: "port1"}},
+				}},
+				Links: []*opb.Link{{A: "dut:port1", B: "ate:port1"}},
+			}
+			got, err := dynamicReservation(context.Background(), tb, resolver{b}, test.partial)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("dynamicReservation() got error %v, want error %t", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			dims := got.DUTs["dut"].(*staticDUT).Dims
+			if dims.Name != test.wantDUT {
+				t.Errorf("dynamicReservation() got DUT %q, want %q", dims.Name, test.wantDUT)
+			}
+			if test.wantPort != "" && dims.Ports["port1"].Name != test.wantPort {
+				t.Errorf("dynamicReservation() got DUT port %q, want %q", dims.Ports["port1"].Name, test.wantPort)
+			}
+		})
+	}
+}
+
+func TestSmallestFirst(t *testing.T) {
+	b := &bindpb.Binding{
+		Dynamic: true,
+		Duts: []*bindpb.Device{
+			{Name: "dut1", Ports: []*bindpb.Port{{Name: "1"}, {Name: "2"}}},
+			{Name: "dut2", Ports: []*bindpb.Port{{Name: "1"}}},
+		},
+		Ates: []*bindpb.Device{
+			{Name: "ate", Ports: []*bindpb.Port{{Name: "1"}, {Name: "2"}, {Name: "3"}}},
+		},
+		Links: []*bindpb.Link{
+			{A: "dut1:1", B: "ate:1"},
+			{A: "dut1:2", B: "dut2:1"},
+			{A: "dut2:1", B: "ate:3"},
+		},
+	}
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}, Ates: []*opb.Device{{Id: "ate"}}}
+
+	want := []*bindpb.Binding{{
+		Dynamic: true,
+		Duts:    []*bindpb.Device{b.Duts[1]},
+		Ates:    b.Ates,
+		Links:   []*bindpb.Link{b.Links[2]},
+	}, b}
+	got := smallestFirst(b, tb, nil)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("smallestFirst() got unexpected diff (-want, +got):\n%s", diff)
+	}
+
+	// The sub-binding without the pinned DUT is left out.
+	partial := map[string]string{"dut": "dut1", "dut:port1": "1"}
+	got = smallestFirst(b, tb, partial)
+	if diff := cmp.Diff([]*bindpb.Binding{b}, got, protocmp.Transform()); diff != "" {
+		t.Errorf("smallestFirst(%v) got unexpected diff (-want, +got):\n%s", partial, diff)
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakebind implements an Ondatra binding of fake DUTs that run in the
+// test process, so that helpers that take an *ondatra.DUTDevice can be unit
+// tested without hardware or KNE.
+//
+// Each DUT has an in-memory gNMI server with the OpenConfig schema, in which
+// config that is set is reflected in state; fake gNOI System and File
+// services; and a fake gRIBI server.  A unit test installs the DUTs with
+// Setup, and seeds the state that the helper under test expects with
+// SetState:
+//
+//	func TestHelper(t *testing.T) {
+//	  duts := fakebind.Setup(t, nil)
+//	  dut := ondatra.DUT(t, "dut")
+//	  fakebind.SetState(t, duts["dut"], gnmi.OC().Interface("Ethernet1").Counters().InPkts().State(), 100)
+//	  ...
+//	}
+//
+// Alternatively, a test package may pass New to ondatra.RunTests, to reserve
+// fake DUTs for the DUTs of its testbed.  ATEs are not supported.
+package fakebind
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnoigo"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/binding/introspect"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygnmi/ygnmi"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	fpb "github.com/openconfig/gnoi/file"
+	spb "github.com/openconfig/gnoi/system"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	ofakebind "github.com/openconfig/ondatra/fakebind"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+const (
+	// bufSize is the buffer size of the in-process connections to a DUT.
+	bufSize = 1 << 20
+	// dialTarget is the target that the connections to a DUT are dialed with.
+	dialTarget = "passthrough:///fakebind"
+)
+
+var _ binding.DUT = (*DUT)(nil)
+
+// DUT is a fake DUT, whose services run in the test process.  The fields
+// give access to the fake services, to seed and inspect their state.
+type DUT struct {
+	*binding.AbstractDUT
+	GNMI   *GNMI
+	System *System
+	File   *File
+	GRIBI  *GRIBI
+
+	srv *grpc.Server
+	lis *bufconn.Listener
+}
+
+// NewDUT returns a fake DUT of the dimensions, with the OpenConfig schema.  The
+// interfaces of its ports are up, and the DUT must be closed after use.
+func NewDUT(dims *binding.Dims) (*DUT, error) {
+	root, ok := oc.SchemaTree["Root"]
+	if !ok {
+		return nil, errors.New("OpenConfig schema has no root")
+	}
+	return newDUT(dims, root)
+}
+
+func newDUT(dims *binding.Dims, root *yang.Entry) (*DUT, error) {
+	g := newGNMI(root)
+	if err := g.Update(seed(dims)); err != nil {
+		return nil, fmt.Errorf("could not seed the state of DUT %s: %w", dims.Name, err)
+	}
+	f := newFile()
+	d := &DUT{
+		AbstractDUT: &binding.AbstractDUT{Dims: dims},
+		GNMI:        g,
+		System:      &System{gnmi: g, file: f},
+		File:        f,
+		GRIBI:       newGRIBI(),
+		srv:         grpc.NewServer(),
+		lis:         bufconn.Listen(bufSize),
+	}
+	gpb.RegisterGNMIServer(d.srv, d.GNMI)
+	spb.RegisterSystemServer(d.srv, d.System)
+	fpb.RegisterFileServer(d.srv, d.File)
+	grpb.RegisterGRIBIServer(d.srv, d.GRIBI)
+	go d.srv.Serve(d.lis)
+	return d, nil
+}
+
+// seed returns the state of a DUT of the dimensions when it starts.
+func seed(dims *binding.Dims) *gpb.Notification {
+	now := time.Now().UnixNano()
+	n := &gpb.Notification{Timestamp: now}
+	add := func(val *gpb.TypedValue, names ...string) {
+		var elems []*gpb.PathElem
+		for _, name := range names {
+			elems = append(elems, &gpb.PathElem{Name: name})
+		}
+		n.Update = append(n.Update, &gpb.Update{Path: &gpb.Path{Elem: elems}, Val: val})
+	}
+	str := func(s string) *gpb.TypedValue {
+		return &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: s}}
+	}
+	add(str(dims.Name), "system", "state", "hostname")
+	add(&gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: uint64(now)}}, "system", "state", "boot-time")
+
+	var names []string
+	for _, p := range dims.Ports {
+		names = append(names, p.Name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		intf := []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": name}}}
+		leaves := []struct {
+			elems []string
+			val   string
+		}{
+			{[]string{"name"}, name},
+			{[]string{"state", "name"}, name},
+			{[]string{"state", "type"}, "iana-if-type:ethernetCsmacd"},
+			{[]string{"state", "admin-status"}, "UP"},
+			{[]string{"state", "oper-status"}, "UP"},
+		}
+		for _, l := range leaves {
+			elems := slices.Clone(intf)
+			for _, e := range l.elems {
+				elems = append(elems, &gpb.PathElem{Name: e})
+			}
+			n.Update = append(n.Update, &gpb.Update{Path: &gpb.Path{Elem: elems}, Val: str(l.val)})
+		}
+	}
+	return n
+}
+
+// Close stops the services of the DUT.
+func (d *DUT) Close() {
+	d.srv.Stop()
+}
+
+// Dialer returns a dialer of the in-process connections to the DUT, which
+// serves all its services on the same connection.
+func (d *DUT) Dialer(introspect.Service) (*introspect.Dialer, error) {
+	return &introspect.Dialer{
+		DialFunc: func(_ context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+			return grpc.NewClient(target, opts...)
+		},
+		DialTarget: dialTarget,
+		DialOpts: []grpc.DialOption{
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return d.lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	}, nil
+}
+
+func (d *DUT) dial(ctx context.Context, svc introspect.Service, opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	dialer, err := d.Dialer(svc)
+	if err != nil {
+		return nil, err
+	}
+	return dialer.Dial(ctx, opts...)
+}
+
+func (d *DUT) DialGNMI(ctx context.Context, opts ...grpc.DialOption) (gpb.GNMIClient, error) {
+	conn, err := d.dial(ctx, introspect.GNMI, opts)
+	if err != nil {
+		return nil, err
+	}
+	return gpb.NewGNMIClient(conn), nil
+}
+
+func (d *DUT) DialGNOI(ctx context.Context, opts ...grpc.DialOption) (gnoigo.Clients, error) {
+	conn, err := d.dial(ctx, introspect.GNOI, opts)
+	if err != nil {
+		return nil, err
+	}
+	return gnoigo.NewClients(conn), nil
+}
+
+func (d *DUT) DialGRIBI(ctx context.Context, opts ...grpc.DialOption) (grpb.GRIBIClient, error) {
+	conn, err := d.dial(ctx, introspect.GRIBI, opts)
+	if err != nil {
+		return nil, err
+	}
+	return grpb.NewGRIBIClient(conn), nil
+}
+
+var _ binding.Binding = (*Binding)(nil)
+
+// Binding reserves fake DUTs for the DUTs of a testbed.
+type Binding struct {
+	duts []*DUT
+}
+
+// New returns a binding of fake DUTs, to pass to ondatra.RunTests.
+func New() (binding.Binding, error) {
+	return new(Binding), nil
+}
+
+// Reserve creates a fake DUT for each DUT of the testbed.
+func (b *Binding) Reserve(_ context.Context, tb *opb.Testbed, _, _ time.Duration, _ map[string]string) (*binding.Reservation, error) {
+	if len(tb.GetAtes()) > 0 {
+		return nil, errors.New("fake binding does not support ATEs")
+	}
+	resv := &binding.Reservation{
+		ID:   "fakebind",
+		DUTs: make(map[string]binding.DUT),
+		ATEs: make(map[string]binding.ATE),
+	}
+	for _, td := range tb.GetDuts() {
+		d, err := NewDUT(dims(td))
+		if err != nil {
+			b.Release(context.Background())
+			return nil, err
+		}
+		b.duts = append(b.duts, d)
+		resv.DUTs[td.GetId()] = d
+	}
+	return resv, nil
+}
+
+// Release closes the fake DUTs.
+func (b *Binding) Release(context.Context) error {
+	for _, d := range b.duts {
+		d.Close()
+	}
+	b.duts = nil
+	return nil
+}
+
+// FetchReservation returns an error, as the fake DUTs do not outlive the
+// reservation.
+func (b *Binding) FetchReservation(context.Context, string) (*binding.Reservation, error) {
+	return nil, errors.New("fake binding cannot fetch reservations")
+}
+
+// dims returns the dimensions of the fake DUT of the testbed DUT.  Its ports
+// are named Ethernet1, Ethernet2 and so on, in the order of the testbed.
+func dims(td *opb.Device) *binding.Dims {
+	d := &binding.Dims{
+		Name:            td.GetId(),
+		Vendor:          td.GetVendor(),
+		HardwareModel:   td.GetHardwareModel(),
+		SoftwareVersion: td.GetSoftwareVersion(),
+		Ports:           make(map[string]*binding.Port),
+	}
+	if d.Vendor == opb.Device_VENDOR_UNSPECIFIED {
+		d.Vendor = opb.Device_OPENCONFIG
+	}
+	for i, p := range td.GetPorts() {
+		d.Ports[p.GetId()] = &binding.Port{
+			Name:      fmt.Sprintf("Ethernet%d", i+1),
+			Speed:     p.GetSpeed(),
+			CardModel: p.GetCardModel(),
+			PMD:       p.GetPmd(),
+		}
+	}
+	return d
+}
+
+// defaultTestbed is the testbed of Setup if none is given.
+var defaultTestbed = &opb.Testbed{
+	Duts: []*opb.Device{{
+		Id:    "dut",
+		Ports: []*opb.Port{{Id: "port1"}, {Id: "port2"}},
+	}},
+}
+
+// Setup reserves fake DUTs for the DUTs of the testbed, or for a DUT "dut"
+// with ports "port1" and "port2" if it is nil, so that ondatra.DUT returns
+// them for the rest of the test.  It returns the fake DUTs by ID, and releases
+// them when the test ends.
+func Setup(t testing.TB, tb *opb.Testbed) map[string]*DUT {
+	t.Helper()
+	if tb == nil {
+		tb = defaultTestbed
+	}
+	b := new(Binding)
+	resv, err := b.Reserve(context.Background(), tb, 0, 0, nil)
+	if err != nil {
+		t.Fatalf("Reserve() got error: %v", err)
+	}
+	ofakebind.Setup().WithReservation(resv)
+	t.Cleanup(func() {
+		ofakebind.Setup()
+		b.Release(context.Background())
+	})
+	duts := make(map[string]*DUT)
+	for id, d := range resv.DUTs {
+		duts[id] = d.(*DUT)
+	}
+	return duts
+}
+
+// SetState sets the value of the query in the gNMI server of the DUT, as
+// though the DUT reported it.  Unlike config that is set with gNMI, the value
+// may be state.
+func SetState[T any](t testing.TB, d *DUT, q ygnmi.SingletonQuery[T], val T) {
+	t.Helper()
+	path, _, err := ygnmi.ResolvePath(q.PathStruct())
+	if err != nil {
+		t.Fatalf("SetState() could not resolve path: %v", err)
+	}
+	// Structs are marshalled with their state paths, if the query is of state.
+	jc := &ygot.RFC7951JSONConfig{AppendModuleName: true, PreferShadowPath: q.IsState()}
+	tv, err := ygot.EncodeTypedValue(val, gpb.Encoding_JSON_IETF, jc)
+	if err != nil {
+		t.Fatalf("SetState() could not encode %v: %v", val, err)
+	}
+	n := &gpb.Notification{
+		Timestamp: time.Now().UnixNano(),
+		Update:    []*gpb.Update{{Path: path, Val: tv}},
+	}
+	if err := d.GNMI.Update(n); err != nil {
+		t.Fatalf("SetState() of %s got error: %v", pathString(path.GetElem()), err)
+	}
+}
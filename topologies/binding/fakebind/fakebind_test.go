@@ -0,0 +1,92 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openconfig/ondatra"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ondatra/gnmi"
+	"github.com/openconfig/ondatra/gnmi/oc"
+	"github.com/openconfig/ygot/ygot"
+
+	spb "github.com/openconfig/gnoi/system"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	opb "github.com/openconfig/ondatra/proto"
+)
+
+func TestSetup(t *testing.T) {
+	duts := Setup(t, nil)
+	dut := ondatra.DUT(t, "dut")
+	name := dut.Port(t, "port1").Name()
+	if name != "Ethernet1" {
+		t.Errorf("Port(port1).Name() got %q, want %q", name, "Ethernet1")
+	}
+	intf := gnmi.OC().Interface(name)
+
+	if got, want := gnmi.Get(t, dut, intf.OperStatus().State()), oc.Interface_OperStatus_UP; got != want {
+		t.Errorf("Get(OperStatus) got %v, want %v", got, want)
+	}
+	if got, want := gnmi.Get(t, dut, gnmi.OC().System().Hostname().State()), "dut"; got != want {
+		t.Errorf("Get(Hostname) got %q, want %q", got, want)
+	}
+
+	gnmi.Replace(t, dut, intf.Config(), &oc.Interface{
+		Name:        &name,
+		Description: ygot.String("uplink"),
+		Type:        oc.IETFInterfaces_InterfaceType_ethernetCsmacd,
+	})
+	if got, want := gnmi.Get(t, dut, intf.Description().State()), "uplink"; got != want {
+		t.Errorf("Get(Description) after Replace() got %q, want %q", got, want)
+	}
+	gnmi.Delete(t, dut, intf.Description().Config())
+	if _, ok := gnmi.Lookup(t, dut, intf.Description().State()).Val(); ok {
+		t.Errorf("Lookup(Description) after Delete() got a value, want none")
+	}
+
+	SetState(t, duts["dut"], intf.Counters().InPkts().State(), 100)
+	if got, want := gnmi.Get(t, dut, intf.Counters().InPkts().State()), uint64(100); got != want {
+		t.Errorf("Get(InPkts) after SetState() got %d, want %d", got, want)
+	}
+
+	ctx := context.Background()
+	if _, err := dut.RawAPIs().GNOI(t).System().Time(ctx, &spb.TimeRequest{}); err != nil {
+		t.Errorf("Time() got error: %v", err)
+	}
+	if _, err := dut.RawAPIs().GRIBI(t).Flush(ctx, &grpb.FlushRequest{
+		NetworkInstance: &grpb.FlushRequest_All{All: &grpb.Empty{}},
+	}); err != nil {
+		t.Errorf("Flush() got error: %v", err)
+	}
+}
+
+func TestReserve_ATE(t *testing.T) {
+	b := new(Binding)
+	tb := &opb.Testbed{Ates: []*opb.Device{{Id: "ate"}}}
+	if _, err := b.Reserve(context.Background(), tb, 0, 0, nil); err == nil {
+		t.Errorf("Reserve() of an ATE got no error, want error")
+	}
+}
+
+func TestNewDUT_SeedError(t *testing.T) {
+	// The schema has no system container for the seeded hostname.
+	root := container("device", container("interfaces"))
+	if d, err := newDUT(&binding.Dims{Name: "dut"}, root); err == nil {
+		d.Close()
+		t.Fatalf("newDUT() with a schema without system got no error, want error")
+	}
+}
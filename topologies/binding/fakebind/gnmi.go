@@ -0,0 +1,661 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// cliOrigin is the origin of the CLI config in Set requests.
+	cliOrigin = "cli"
+	// defaultSampleInterval is the sample interval of subscriptions that do
+	// not specify one.
+	defaultSampleInterval = 10 * time.Second
+)
+
+// GNMI is a fake gNMI server, which keeps the config and state of a device in
+// memory.  The paths and values of requests are checked against the schema,
+// and the config that is set is reflected in the state leaves of the same
+// names, as a device would.
+type GNMI struct {
+	gpb.UnimplementedGNMIServer
+	schema *schema
+
+	mu     sync.Mutex
+	leaves leafMap
+	subs   map[*subscriber]bool
+	cli    []string
+}
+
+func newGNMI(root *yang.Entry) *GNMI {
+	return &GNMI{
+		schema: &schema{root: root},
+		leaves: make(leafMap),
+		subs:   make(map[*subscriber]bool),
+	}
+}
+
+// CLIConfig returns the config that was set with the "cli" origin, in order.
+func (g *GNMI) CLIConfig() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return slices.Clone(g.cli)
+}
+
+// Update sets the leaves of the updates of the notification, and deletes the
+// leaves under its delete paths, as the device would report them.  Unlike a
+// Set request, it may set state, and it does not reflect config in state.
+func (g *GNMI) Update(n *gpb.Notification) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ts := n.GetTimestamp()
+	if ts == 0 {
+		ts = time.Now().UnixNano()
+	}
+	next := maps.Clone(g.leaves)
+	for _, p := range n.GetDelete() {
+		_, elems := fullPath(n.GetPrefix(), p)
+		next.remove(elems, func(*leaf) bool { return true })
+	}
+	for _, u := range n.GetUpdate() {
+		_, elems := fullPath(n.GetPrefix(), u.GetPath())
+		ls, err := g.schema.leaves(elems, u.GetVal())
+		if err != nil {
+			return err
+		}
+		for _, l := range ls {
+			l.ts = ts
+			next.put(l)
+			if err := g.putKeys(next, l); err != nil {
+				return err
+			}
+		}
+	}
+	g.commit(next, ts)
+	return nil
+}
+
+// Capabilities implements the gNMI Capabilities RPC.
+func (g *GNMI) Capabilities(context.Context, *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+	return &gpb.CapabilityResponse{
+		SupportedEncodings: []gpb.Encoding{gpb.Encoding_JSON, gpb.Encoding_JSON_IETF, gpb.Encoding_PROTO},
+		GNMIVersion:        "0.10.0",
+	}, nil
+}
+
+// Get implements the gNMI Get RPC.  Only the PROTO encoding supports wildcards.
+func (g *GNMI) Get(_ context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+	enc := req.GetEncoding()
+	switch enc {
+	case gpb.Encoding_JSON, gpb.Encoding_JSON_IETF, gpb.Encoding_PROTO:
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "unsupported encoding %v", enc)
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ts := time.Now().UnixNano()
+	resp := new(gpb.GetResponse)
+	for _, p := range req.GetPath() {
+		origin, elems := fullPath(req.GetPrefix(), p)
+		if err := checkOrigin(origin); err != nil {
+			return nil, err
+		}
+		if _, err := g.schema.lookup(elems, true); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid path: %v", err)
+		}
+		ls := g.leaves.match([][]*gpb.PathElem{elems}, func(l *leaf) bool {
+			switch req.GetType() {
+			case gpb.GetRequest_CONFIG:
+				return !l.readOnly
+			case gpb.GetRequest_STATE, gpb.GetRequest_OPERATIONAL:
+				return l.readOnly
+			}
+			return true
+		})
+		if len(ls) == 0 {
+			return nil, status.Errorf(codes.NotFound, "no data at %s", pathString(elems))
+		}
+		n := &gpb.Notification{
+			Timestamp: ts,
+			Prefix:    &gpb.Path{Origin: origin, Target: req.GetPrefix().GetTarget()},
+		}
+		if enc == gpb.Encoding_PROTO {
+			for _, l := range ls {
+				n.Update = append(n.Update, &gpb.Update{Path: &gpb.Path{Elem: l.elems}, Val: l.val})
+			}
+		} else {
+			if hasWildcards(elems) {
+				return nil, status.Errorf(codes.InvalidArgument, "wildcard path %s needs the PROTO encoding", pathString(elems))
+			}
+			data, err := jsonTree(elems, ls)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "could not encode %s: %v", pathString(elems), err)
+			}
+			val := &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: data}}
+			if enc == gpb.Encoding_JSON {
+				val = &gpb.TypedValue{Value: &gpb.TypedValue_JsonVal{JsonVal: data}}
+			}
+			n.Update = []*gpb.Update{{Path: &gpb.Path{Elem: elems}, Val: val}}
+		}
+		resp.Notification = append(resp.Notification, n)
+	}
+	return resp, nil
+}
+
+// Set implements the gNMI Set RPC.  The config of the "cli" origin is only
+// recorded.  Either all the operations of the request succeed, or none do.
+func (g *GNMI) Set(_ context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ts := time.Now().UnixNano()
+	next := maps.Clone(g.leaves)
+	resp := &gpb.SetResponse{Prefix: req.GetPrefix(), Timestamp: ts}
+	var cli []string
+
+	for _, p := range req.GetDelete() {
+		origin, elems := fullPath(req.GetPrefix(), p)
+		if err := checkOrigin(origin); err != nil {
+			return nil, err
+		}
+		if _, err := g.schema.lookup(elems, false); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delete path: %v", err)
+		}
+		next.removeConfig(elems)
+		resp.Response = append(resp.Response, &gpb.UpdateResult{Path: p, Op: gpb.UpdateResult_DELETE})
+	}
+	ops := []struct {
+		updates []*gpb.Update
+		op      gpb.UpdateResult_Operation
+	}{
+		{req.GetReplace(), gpb.UpdateResult_REPLACE},
+		{req.GetUnionReplace(), gpb.UpdateResult_UNION_REPLACE},
+		{req.GetUpdate(), gpb.UpdateResult_UPDATE},
+	}
+	for _, op := range ops {
+		for _, u := range op.updates {
+			origin, elems := fullPath(req.GetPrefix(), u.GetPath())
+			if origin == cliOrigin {
+				text, err := scalarString(u.GetVal())
+				if err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid CLI config: %v", err)
+				}
+				cli = append(cli, text)
+			} else if err := g.set(next, origin, elems, u.GetVal(), op.op != gpb.UpdateResult_UPDATE, ts); err != nil {
+				return nil, err
+			}
+			resp.Response = append(resp.Response, &gpb.UpdateResult{Path: u.GetPath(), Op: op.op})
+		}
+	}
+	g.commit(next, ts)
+	g.cli = append(g.cli, cli...)
+	return resp, nil
+}
+
+// set sets the config of the value at the path in the leaves, replacing the
+// config under the path if replace is true.
+func (g *GNMI) set(leaves leafMap, origin string, elems []*gpb.PathElem, val *gpb.TypedValue, replace bool, ts int64) error {
+	if err := checkOrigin(origin); err != nil {
+		return err
+	}
+	ls, err := g.schema.leaves(elems, val)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid value: %v", err)
+	}
+	for _, l := range ls {
+		if l.readOnly {
+			return status.Errorf(codes.InvalidArgument, "%s is state, which cannot be set", pathString(l.elems))
+		}
+	}
+	if replace {
+		leaves.removeConfig(elems)
+	}
+	for _, l := range ls {
+		l.ts = ts
+		leaves.put(l)
+		if sl := g.schema.stateLeaf(l); sl != nil {
+			leaves.put(sl)
+		}
+		if err := g.putKeys(leaves, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putKeys puts the key leaves of the list entries of the path of the leaf, as a
+// device creates them with the entries.
+func (g *GNMI) putKeys(leaves leafMap, l *leaf) error {
+	for i, e := range l.elems {
+		for k := range e.GetKey() {
+			entry := l.elems[:i+1]
+			if _, ok := leaves[pathString(append(slices.Clip(entry), &gpb.PathElem{Name: k}))]; ok {
+				continue
+			}
+			kl, err := g.schema.keyLeaf(entry, k)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid key: %v", err)
+			}
+			kl.ts = l.ts
+			leaves.put(kl)
+		}
+	}
+	return nil
+}
+
+// commit replaces the leaves with the next leaves, and reports the changes to
+// the subscriptions.  It must be called with mu held.
+func (g *GNMI) commit(next leafMap, ts int64) {
+	var updates []*leaf
+	var deletes [][]*gpb.PathElem
+	for _, k := range next.sortedKeys() {
+		if l := next[k]; g.leaves[k] != l {
+			updates = append(updates, l)
+		}
+	}
+	for _, k := range g.leaves.sortedKeys() {
+		if _, ok := next[k]; !ok {
+			deletes = append(deletes, g.leaves[k].elems)
+		}
+	}
+	g.leaves = next
+	for sub := range g.subs {
+		n := &gpb.Notification{Timestamp: ts, Prefix: sub.prefix}
+		for _, l := range updates {
+			if sub.matches(l.elems) {
+				n.Update = append(n.Update, &gpb.Update{Path: &gpb.Path{Elem: l.elems}, Val: l.val})
+			}
+		}
+		for _, d := range deletes {
+			if sub.matches(d) {
+				n.Delete = append(n.Delete, &gpb.Path{Elem: d})
+			}
+		}
+		if len(n.Update)+len(n.Delete) > 0 {
+			sub.push(n)
+		}
+	}
+}
+
+// Subscribe implements the gNMI Subscribe RPC.  In STREAM mode, ON_CHANGE and
+// TARGET_DEFINED subscriptions report each change, and SAMPLE subscriptions
+// report all their leaves at each interval.
+func (g *GNMI) Subscribe(stream gpb.GNMI_SubscribeServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	sl := req.GetSubscribe()
+	if sl == nil {
+		return status.Error(codes.InvalidArgument, "first request must be a subscription list")
+	}
+	prefix := &gpb.Path{Target: sl.GetPrefix().GetTarget()}
+	var all, onChange [][]*gpb.PathElem
+	samples := make(map[time.Duration][][]*gpb.PathElem)
+	for i, sub := range sl.GetSubscription() {
+		origin, elems := fullPath(sl.GetPrefix(), sub.GetPath())
+		if err := checkOrigin(origin); err != nil {
+			return err
+		}
+		// The notifications have the origin of the subscriptions in their
+		// prefix, so the subscriptions must have the same origin.
+		if i == 0 {
+			prefix.Origin = origin
+		} else if origin != prefix.GetOrigin() {
+			return status.Errorf(codes.InvalidArgument, "subscriptions have different origins %q and %q", prefix.GetOrigin(), origin)
+		}
+		if _, err := g.schema.lookup(elems, true); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid subscription path: %v", err)
+		}
+		all = append(all, elems)
+		if sub.GetMode() == gpb.SubscriptionMode_SAMPLE {
+			interval := time.Duration(sub.GetSampleInterval())
+			if interval == 0 {
+				interval = defaultSampleInterval
+			}
+			samples[interval] = append(samples[interval], elems)
+		} else {
+			onChange = append(onChange, elems)
+		}
+	}
+
+	switch sl.GetMode() {
+	case gpb.SubscriptionList_ONCE:
+		return g.sendSnapshot(stream, prefix, all)
+	case gpb.SubscriptionList_POLL:
+		for {
+			if err := g.sendSnapshot(stream, prefix, all); err != nil {
+				return err
+			}
+			req, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if req.GetPoll() == nil {
+				return status.Error(codes.InvalidArgument, "subscription in POLL mode got a request that is not a poll")
+			}
+		}
+	}
+	return g.stream(stream, prefix, sl.GetUpdatesOnly(), all, onChange, samples)
+}
+
+// stream serves a subscription in STREAM mode.
+func (g *GNMI) stream(stream gpb.GNMI_SubscribeServer, prefix *gpb.Path, updatesOnly bool, all, onChange [][]*gpb.PathElem, samples map[time.Duration][][]*gpb.PathElem) error {
+	ctx := stream.Context()
+	sub := &subscriber{prefix: prefix, paths: onChange, ready: make(chan struct{}, 1)}
+	g.mu.Lock()
+	var initial []*gpb.Notification
+	if !updatesOnly {
+		initial = g.snapshot(prefix, all, 0)
+	}
+	if len(onChange) > 0 {
+		g.subs[sub] = true
+	}
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		delete(g.subs, sub)
+		g.mu.Unlock()
+	}()
+	for _, n := range initial {
+		if err := stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}}); err != nil {
+			return err
+		}
+	}
+	if err := stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}}); err != nil {
+		return err
+	}
+
+	ticks := make(chan time.Duration)
+	for interval := range samples {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case ticks <- interval:
+				}
+			}
+		}()
+	}
+	for {
+		var ns []*gpb.Notification
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sub.ready:
+			ns = sub.pop()
+		case interval := <-ticks:
+			g.mu.Lock()
+			ns = g.snapshot(prefix, samples[interval], time.Now().UnixNano())
+			g.mu.Unlock()
+		}
+		for _, n := range ns {
+			if err := stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendSnapshot sends the leaves under the paths, followed by a sync response.
+func (g *GNMI) sendSnapshot(stream gpb.GNMI_SubscribeServer, prefix *gpb.Path, paths [][]*gpb.PathElem) error {
+	g.mu.Lock()
+	ns := g.snapshot(prefix, paths, 0)
+	g.mu.Unlock()
+	for _, n := range ns {
+		if err := stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_Update{Update: n}}); err != nil {
+			return err
+		}
+	}
+	return stream.Send(&gpb.SubscribeResponse{Response: &gpb.SubscribeResponse_SyncResponse{SyncResponse: true}})
+}
+
+// snapshot returns notifications of the leaves under the paths, one for each
+// time at which they were set, or one at the time if it is not zero.  It must
+// be called with mu held.
+func (g *GNMI) snapshot(prefix *gpb.Path, paths [][]*gpb.PathElem, ts int64) []*gpb.Notification {
+	byTime := make(map[int64]*gpb.Notification)
+	for _, l := range g.leaves.match(paths, nil) {
+		t := ts
+		if t == 0 {
+			t = l.ts
+		}
+		n, ok := byTime[t]
+		if !ok {
+			n = &gpb.Notification{Timestamp: t, Prefix: prefix}
+			byTime[t] = n
+		}
+		n.Update = append(n.Update, &gpb.Update{Path: &gpb.Path{Elem: l.elems}, Val: l.val})
+	}
+	var ns []*gpb.Notification
+	for _, n := range byTime {
+		ns = append(ns, n)
+	}
+	sort.Slice(ns, func(i, j int) bool { return ns[i].GetTimestamp() < ns[j].GetTimestamp() })
+	return ns
+}
+
+// subscriber queues the changes for a subscription in STREAM mode.
+type subscriber struct {
+	prefix *gpb.Path
+	paths  [][]*gpb.PathElem
+	ready  chan struct{} // Signaled when notifications are queued.
+
+	mu      sync.Mutex
+	pending []*gpb.Notification
+}
+
+func (s *subscriber) matches(elems []*gpb.PathElem) bool {
+	for _, p := range s.paths {
+		if matches(p, elems) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *subscriber) push(n *gpb.Notification) {
+	s.mu.Lock()
+	s.pending = append(s.pending, n)
+	s.mu.Unlock()
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (s *subscriber) pop() []*gpb.Notification {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ns := s.pending
+	s.pending = nil
+	return ns
+}
+
+// leafMap is a datastore of leaves by path.
+type leafMap map[string]*leaf
+
+// put adds the leaf, unless it has the same value as the leaf it replaces, so
+// that unchanged leaves are not reported.
+func (m leafMap) put(l *leaf) {
+	k := pathString(l.elems)
+	if old, ok := m[k]; ok && old.readOnly == l.readOnly && old.derived == l.derived && proto.Equal(old.val, l.val) {
+		return
+	}
+	m[k] = l
+}
+
+// remove removes the leaves under the path for which the function is true.
+func (m leafMap) remove(elems []*gpb.PathElem, fn func(*leaf) bool) {
+	for k, l := range m {
+		if matches(elems, l.elems) && fn(l) {
+			delete(m, k)
+		}
+	}
+}
+
+// removeConfig removes the config under the path, and the state that reflects
+// it, but not other state.
+func (m leafMap) removeConfig(elems []*gpb.PathElem) {
+	m.remove(elems, func(l *leaf) bool {
+		if l.readOnly {
+			return l.derived
+		}
+		if state := stateElems(l.elems); state != nil {
+			if sl, ok := m[pathString(state)]; ok && sl.derived {
+				delete(m, pathString(state))
+			}
+		}
+		return true
+	})
+}
+
+// match returns the leaves under any of the paths for which the function, if
+// any, is true, sorted by path.
+func (m leafMap) match(paths [][]*gpb.PathElem, fn func(*leaf) bool) []*leaf {
+	var ls []*leaf
+	for _, k := range m.sortedKeys() {
+		l := m[k]
+		if fn != nil && !fn(l) {
+			continue
+		}
+		for _, p := range paths {
+			if matches(p, l.elems) {
+				ls = append(ls, l)
+				break
+			}
+		}
+	}
+	return ls
+}
+
+func (m leafMap) sortedKeys() []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+// checkOrigin returns an error if the origin is not OpenConfig.
+func checkOrigin(origin string) error {
+	if origin != "" && origin != "openconfig" {
+		return status.Errorf(codes.Unimplemented, "unsupported origin %q", origin)
+	}
+	return nil
+}
+
+// fullPath returns the origin and elements of the path after the prefix, with
+// the module prefixes stripped from the names of the elements.
+func fullPath(prefix, p *gpb.Path) (string, []*gpb.PathElem) {
+	origin := p.GetOrigin()
+	if origin == "" {
+		origin = prefix.GetOrigin()
+	}
+	var elems []*gpb.PathElem
+	for _, e := range append(slices.Clip(prefix.GetElem()), p.GetElem()...) {
+		elems = append(elems, &gpb.PathElem{Name: stripPrefix(e.GetName()), Key: e.GetKey()})
+	}
+	return origin, elems
+}
+
+// matches returns whether the path is at or under the pattern, in which names
+// may be "*", keys may be "*" or missing, and "..." matches any elements.
+func matches(pattern, elems []*gpb.PathElem) bool {
+	for i, p := range pattern {
+		if p.GetName() == "..." {
+			for j := i; j <= len(elems); j++ {
+				if matches(pattern[i+1:], elems[j:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if i >= len(elems) {
+			return false
+		}
+		e := elems[i]
+		if p.GetName() != "*" && p.GetName() != e.GetName() {
+			return false
+		}
+		for k, v := range p.GetKey() {
+			if v != "*" && e.GetKey()[k] != v {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// hasWildcards returns whether the path has wildcard names or keys.
+func hasWildcards(elems []*gpb.PathElem) bool {
+	for _, e := range elems {
+		if e.GetName() == "*" || e.GetName() == "..." {
+			return true
+		}
+		for _, v := range e.GetKey() {
+			if v == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pathString returns the path as a string, with its keys sorted.
+func pathString(elems []*gpb.PathElem) string {
+	if len(elems) == 0 {
+		return "/"
+	}
+	var b strings.Builder
+	for _, e := range elems {
+		b.WriteString("/" + e.GetName())
+		keys := make([]string, 0, len(e.GetKey()))
+		for k := range e.GetKey() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "[%s=%s]", k, e.GetKey()[k])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,587 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/goyang/pkg/yang"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func container(name string, children ...*yang.Entry) *yang.Entry {
+	e := &yang.Entry{Name: name, Kind: yang.DirectoryEntry, Dir: make(map[string]*yang.Entry)}
+	for _, c := range children {
+		e.Dir[c.Name] = c
+	}
+	return e
+}
+
+func list(name, key string, children ...*yang.Entry) *yang.Entry {
+	e := container(name, children...)
+	e.Key, e.ListAttr = key, &yang.ListAttr{}
+	return e
+}
+
+func leafEntry(name string, t *yang.YangType) *yang.Entry {
+	return &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: t}
+}
+
+func state(e *yang.Entry) *yang.Entry {
+	e.Config = yang.TSFalse
+	return e
+}
+
+var (
+	stringType = &yang.YangType{Name: "string", Kind: yang.Ystring}
+	uint16Type = &yang.YangType{Name: "uint16", Kind: yang.Yuint16}
+	uint64Type = &yang.YangType{Name: "uint64", Kind: yang.Yuint64}
+	boolType   = &yang.YangType{Name: "boolean", Kind: yang.Ybool}
+	enumType   = &yang.YangType{Name: "enumeration", Kind: yang.Yenum}
+	identType  = &yang.YangType{Name: "identityref", Kind: yang.Yidentityref}
+	nameRef    = &yang.YangType{Name: "leafref", Kind: yang.Yleafref, Path: "../config/name"}
+)
+
+// testSchema returns a small schema in the shape of the OpenConfig schema.
+func testSchema() *yang.Entry {
+	intfConfig := func() []*yang.Entry {
+		return []*yang.Entry{
+			leafEntry("name", stringType),
+			leafEntry("description", stringType),
+			leafEntry("mtu", uint16Type),
+			leafEntry("enabled", boolType),
+			leafEntry("type", identType),
+		}
+	}
+	return container("device",
+		container("interfaces",
+			list("interface", "name",
+				leafEntry("name", nameRef),
+				container("config", intfConfig()...),
+				state(container("state", append(intfConfig(),
+					leafEntry("oper-status", enumType),
+					leafEntry("admin-status", enumType),
+					container("counters", leafEntry("in-pkts", uint64Type)),
+				)...)),
+			),
+		),
+		container("system",
+			state(container("state",
+				leafEntry("hostname", stringType),
+				leafEntry("boot-time", uint64Type),
+			)),
+		),
+		container("components",
+			list("component", "name",
+				leafEntry("name", nameRef),
+				container("config", leafEntry("name", stringType)),
+				state(container("state",
+					leafEntry("name", stringType),
+					leafEntry("redundant-role", enumType),
+				)),
+			),
+		),
+	)
+}
+
+// serve serves the registered services in the test, and returns a connection
+// to them.
+func serve(t *testing.T, register func(*grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(bufSize)
+	srv := grpc.NewServer()
+	register(srv)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient(dialTarget,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() got error: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func newTestGNMI(t *testing.T) (*GNMI, gpb.GNMIClient) {
+	t.Helper()
+	g := newGNMI(testSchema())
+	conn := serve(t, func(s *grpc.Server) { gpb.RegisterGNMIServer(s, g) })
+	return g, gpb.NewGNMIClient(conn)
+}
+
+// mustPath parses a path of the form "/a/b[k=v]/c".
+func mustPath(t *testing.T, s string) *gpb.Path {
+	t.Helper()
+	p := new(gpb.Path)
+	for _, part := range strings.Split(strings.Trim(s, "/"), "/") {
+		if part == "" {
+			continue
+		}
+		name, rest, _ := strings.Cut(part, "[")
+		e := &gpb.PathElem{Name: name}
+		for rest != "" {
+			kv, next, _ := strings.Cut(rest, "]")
+			k, v, ok := strings.Cut(kv, "=")
+			if !ok {
+				t.Fatalf("invalid path %q", s)
+			}
+			if e.Key == nil {
+				e.Key = make(map[string]string)
+			}
+			e.Key[k] = v
+			rest = strings.TrimPrefix(next, "[")
+		}
+		p.Elem = append(p.Elem, e)
+	}
+	return p
+}
+
+func jsonIETF(s string) *gpb.TypedValue {
+	return &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(s)}}
+}
+
+func strVal(s string) *gpb.TypedValue {
+	return &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: s}}
+}
+
+func uintVal(u uint64) *gpb.TypedValue {
+	return &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: u}}
+}
+
+// getLeaves returns the values of the leaves of the path by path, with the
+// PROTO encoding.
+func getLeaves(t *testing.T, c gpb.GNMIClient, path string, typ gpb.GetRequest_DataType) map[string]*gpb.TypedValue {
+	t.Helper()
+	resp, err := c.Get(context.Background(), &gpb.GetRequest{
+		Path:     []*gpb.Path{mustPath(t, path)},
+		Type:     typ,
+		Encoding: gpb.Encoding_PROTO,
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("Get(%s) got error: %v", path, err)
+	}
+	got := make(map[string]*gpb.TypedValue)
+	for _, n := range resp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			got[pathString(u.GetPath().GetElem())] = u.GetVal()
+		}
+	}
+	return got
+}
+
+// getJSON returns the JSON value of the path, decoded.
+func getJSON(t *testing.T, c gpb.GNMIClient, path string) any {
+	t.Helper()
+	resp, err := c.Get(context.Background(), &gpb.GetRequest{
+		Path:     []*gpb.Path{mustPath(t, path)},
+		Encoding: gpb.Encoding_JSON_IETF,
+	})
+	if err != nil {
+		t.Fatalf("Get(%s) got error: %v", path, err)
+	}
+	var v any
+	if err := json.Unmarshal(resp.GetNotification()[0].GetUpdate()[0].GetVal().GetJsonIetfVal(), &v); err != nil {
+		t.Fatalf("Get(%s) got invalid JSON: %v", path, err)
+	}
+	return v
+}
+
+func TestSetGet(t *testing.T) {
+	_, c := newTestGNMI(t)
+	ctx := context.Background()
+	_, err := c.Set(ctx, &gpb.SetRequest{
+		Prefix: &gpb.Path{Origin: "openconfig"},
+		Replace: []*gpb.Update{{
+			Path: mustPath(t, "/interfaces/interface[name=eth0]"),
+			Val: jsonIETF(`{
+				"openconfig-interfaces:name": "eth0",
+				"openconfig-interfaces:config": {
+					"name": "eth0",
+					"description": "uplink",
+					"mtu": 9000,
+					"type": "iana-if-type:ethernetCsmacd"
+				}
+			}`),
+		}},
+		Update: []*gpb.Update{{
+			Path: mustPath(t, "/interfaces/interface[name=eth0]/config/enabled"),
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: true}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Set() got error: %v", err)
+	}
+
+	want := map[string]*gpb.TypedValue{
+		"/interfaces/interface[name=eth0]/state/name":        strVal("eth0"),
+		"/interfaces/interface[name=eth0]/state/description": strVal("uplink"),
+		"/interfaces/interface[name=eth0]/state/mtu":         uintVal(9000),
+		"/interfaces/interface[name=eth0]/state/enabled":     {Value: &gpb.TypedValue_BoolVal{BoolVal: true}},
+		"/interfaces/interface[name=eth0]/state/type":        strVal("ethernetCsmacd"),
+	}
+	got := getLeaves(t, c, "/interfaces/interface[name=eth0]/state", gpb.GetRequest_ALL)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Get() of state got unexpected diff (-want,+got):\n%s", diff)
+	}
+	got = getLeaves(t, c, "/interfaces/interface[name=*]/name", gpb.GetRequest_CONFIG)
+	if diff := cmp.Diff(map[string]*gpb.TypedValue{"/interfaces/interface[name=eth0]/name": strVal("eth0")}, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Get() of keys got unexpected diff (-want,+got):\n%s", diff)
+	}
+	if got := getLeaves(t, c, "/interfaces/interface[name=eth0]/config", gpb.GetRequest_STATE); got != nil {
+		t.Errorf("Get() of config with type STATE got %v, want NotFound", got)
+	}
+
+	wantJSON := map[string]any{
+		"name": "eth0",
+		"config": map[string]any{
+			"name":        "eth0",
+			"description": "uplink",
+			"mtu":         float64(9000),
+			"enabled":     true,
+			"type":        "ethernetCsmacd",
+		},
+		"state": map[string]any{
+			"name":        "eth0",
+			"description": "uplink",
+			"mtu":         float64(9000),
+			"enabled":     true,
+			"type":        "ethernetCsmacd",
+		},
+	}
+	if diff := cmp.Diff(wantJSON, getJSON(t, c, "/interfaces/interface[name=eth0]")); diff != "" {
+		t.Errorf("Get() of JSON got unexpected diff (-want,+got):\n%s", diff)
+	}
+	wantList := map[string]any{"interface": []any{wantJSON}}
+	if diff := cmp.Diff(wantList, getJSON(t, c, "/interfaces")); diff != "" {
+		t.Errorf("Get() of JSON list got unexpected diff (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(float64(9000), getJSON(t, c, "/interfaces/interface[name=eth0]/state/mtu")); diff != "" {
+		t.Errorf("Get() of JSON leaf got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestSetErrors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		path     string
+		val      *gpb.TypedValue
+		origin   string
+		wantCode codes.Code
+	}{{
+		desc:     "state",
+		path:     "/interfaces/interface[name=eth0]/state/description",
+		val:      strVal("uplink"),
+		wantCode: codes.InvalidArgument,
+	}, {
+		desc:     "unknown element",
+		path:     "/interfaces/interface[name=eth0]/config/speed",
+		val:      strVal("fast"),
+		wantCode: codes.InvalidArgument,
+	}, {
+		desc:     "missing key",
+		path:     "/interfaces/interface/config/mtu",
+		val:      uintVal(1500),
+		wantCode: codes.InvalidArgument,
+	}, {
+		desc:     "out of range",
+		path:     "/interfaces/interface[name=eth0]/config/mtu",
+		val:      uintVal(100000),
+		wantCode: codes.InvalidArgument,
+	}, {
+		desc:     "JSON string for number",
+		path:     "/interfaces/interface[name=eth0]/config",
+		val:      jsonIETF(`{"mtu": "1500"}`),
+		wantCode: codes.InvalidArgument,
+	}, {
+		desc:     "JSON state",
+		path:     "/interfaces/interface[name=eth0]",
+		val:      jsonIETF(`{"state": {"oper-status": "UP"}}`),
+		wantCode: codes.InvalidArgument,
+	}, {
+		desc:     "unsupported origin",
+		path:     "/interfaces/interface[name=eth0]/config/mtu",
+		val:      uintVal(1500),
+		origin:   "vendor",
+		wantCode: codes.Unimplemented,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, c := newTestGNMI(t)
+			p := mustPath(t, tt.path)
+			p.Origin = tt.origin
+			_, err := c.Set(context.Background(), &gpb.SetRequest{
+				Update: []*gpb.Update{{
+					Path: mustPath(t, "/interfaces/interface[name=eth1]/config/name"),
+					Val:  strVal("eth1"),
+				}, {
+					Path: p,
+					Val:  tt.val,
+				}},
+			})
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("Set() got error %v, want code %v", err, tt.wantCode)
+			}
+			// The whole request fails, including the update that is valid.
+			if got := getLeaves(t, c, "/interfaces", gpb.GetRequest_ALL); got != nil {
+				t.Errorf("Get() after failed Set() got %v, want no leaves", got)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	g, c := newTestGNMI(t)
+	ctx := context.Background()
+	if err := g.Update(&gpb.Notification{Update: []*gpb.Update{{
+		Path: mustPath(t, "/interfaces/interface[name=eth0]/state/oper-status"),
+		Val:  strVal("UP"),
+	}}}); err != nil {
+		t.Fatalf("Update() got error: %v", err)
+	}
+	if _, err := c.Set(ctx, &gpb.SetRequest{Update: []*gpb.Update{{
+		Path: mustPath(t, "/interfaces/interface[name=eth0]/config"),
+		Val:  jsonIETF(`{"name": "eth0", "description": "uplink"}`),
+	}}}); err != nil {
+		t.Fatalf("Set() got error: %v", err)
+	}
+	if _, err := c.Set(ctx, &gpb.SetRequest{Delete: []*gpb.Path{
+		mustPath(t, "/interfaces/interface[name=eth0]/config/description"),
+	}}); err != nil {
+		t.Fatalf("Set() of delete got error: %v", err)
+	}
+
+	want := map[string]*gpb.TypedValue{
+		"/interfaces/interface[name=eth0]/name":              strVal("eth0"),
+		"/interfaces/interface[name=eth0]/config/name":       strVal("eth0"),
+		"/interfaces/interface[name=eth0]/state/name":        strVal("eth0"),
+		"/interfaces/interface[name=eth0]/state/oper-status": strVal("UP"),
+	}
+	got := getLeaves(t, c, "/interfaces", gpb.GetRequest_ALL)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Get() after delete of leaf got unexpected diff (-want,+got):\n%s", diff)
+	}
+
+	// Deleting the entry leaves the state that the device reports itself.
+	if _, err := c.Set(ctx, &gpb.SetRequest{Delete: []*gpb.Path{
+		mustPath(t, "/interfaces/interface[name=eth0]"),
+	}}); err != nil {
+		t.Fatalf("Set() of delete got error: %v", err)
+	}
+	want = map[string]*gpb.TypedValue{
+		"/interfaces/interface[name=eth0]/state/oper-status": strVal("UP"),
+	}
+	got = getLeaves(t, c, "/interfaces", gpb.GetRequest_ALL)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Get() after delete of entry got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+func TestCLIConfig(t *testing.T) {
+	g, c := newTestGNMI(t)
+	_, err := c.Set(context.Background(), &gpb.SetRequest{Update: []*gpb.Update{{
+		Path: &gpb.Path{Origin: "cli"},
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_AsciiVal{AsciiVal: "hostname dut"}},
+	}}})
+	if err != nil {
+		t.Fatalf("Set() got error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"hostname dut"}, g.CLIConfig()); diff != "" {
+		t.Errorf("CLIConfig() got unexpected diff (-want,+got):\n%s", diff)
+	}
+}
+
+// recvUpdates receives the updates of the subscription until its next sync
+// response, by path.
+func recvUpdates(t *testing.T, sc gpb.GNMI_SubscribeClient) map[string]*gpb.TypedValue {
+	t.Helper()
+	got := make(map[string]*gpb.TypedValue)
+	for {
+		resp, err := sc.Recv()
+		if err != nil {
+			t.Fatalf("Recv() got error: %v", err)
+		}
+		if resp.GetSyncResponse() {
+			return got
+		}
+		for _, u := range resp.GetUpdate().GetUpdate() {
+			got[pathString(u.GetPath().GetElem())] = u.GetVal()
+		}
+	}
+}
+
+// recvNotification receives the next notification of the subscription.
+func recvNotification(t *testing.T, sc gpb.GNMI_SubscribeClient) *gpb.Notification {
+	t.Helper()
+	resp, err := sc.Recv()
+	if err != nil {
+		t.Fatalf("Recv() got error: %v", err)
+	}
+	if resp.GetUpdate() == nil {
+		t.Fatalf("Recv() got %v, want a notification", resp)
+	}
+	return resp.GetUpdate()
+}
+
+func TestSubscribe(t *testing.T) {
+	g, c := newTestGNMI(t)
+	update := func(path string, val *gpb.TypedValue) {
+		t.Helper()
+		if err := g.Update(&gpb.Notification{Update: []*gpb.Update{{Path: mustPath(t, path), Val: val}}}); err != nil {
+			t.Fatalf("Update() got error: %v", err)
+		}
+	}
+	update("/interfaces/interface[name=eth0]/state/oper-status", strVal("UP"))
+	update("/interfaces/interface[name=eth1]/state/oper-status", strVal("DOWN"))
+	subscribe := func(mode gpb.SubscriptionList_Mode, sub *gpb.Subscription) gpb.GNMI_SubscribeClient {
+		t.Helper()
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		sc, err := c.Subscribe(ctx)
+		if err != nil {
+			t.Fatalf("Subscribe() got error: %v", err)
+		}
+		if err := sc.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+			Mode:         mode,
+			Subscription: []*gpb.Subscription{sub},
+		}}}); err != nil {
+			t.Fatalf("Send() got error: %v", err)
+		}
+		return sc
+	}
+
+	t.Run("once", func(t *testing.T) {
+		sc := subscribe(gpb.SubscriptionList_ONCE, &gpb.Subscription{Path: mustPath(t, "/interfaces/interface[name=*]/state/oper-status")})
+		want := map[string]*gpb.TypedValue{
+			"/interfaces/interface[name=eth0]/state/oper-status": strVal("UP"),
+			"/interfaces/interface[name=eth1]/state/oper-status": strVal("DOWN"),
+		}
+		if diff := cmp.Diff(want, recvUpdates(t, sc), protocmp.Transform()); diff != "" {
+			t.Errorf("Subscribe() got unexpected diff (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		sc := subscribe(gpb.SubscriptionList_POLL, &gpb.Subscription{Path: mustPath(t, "/interfaces/interface[name=eth1]/state/oper-status")})
+		recvUpdates(t, sc)
+		update("/interfaces/interface[name=eth1]/state/oper-status", strVal("UP"))
+		if err := sc.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Poll{Poll: &gpb.Poll{}}}); err != nil {
+			t.Fatalf("Send() got error: %v", err)
+		}
+		want := map[string]*gpb.TypedValue{"/interfaces/interface[name=eth1]/state/oper-status": strVal("UP")}
+		if diff := cmp.Diff(want, recvUpdates(t, sc), protocmp.Transform()); diff != "" {
+			t.Errorf("Subscribe() after poll got unexpected diff (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("on change", func(t *testing.T) {
+		sc := subscribe(gpb.SubscriptionList_STREAM, &gpb.Subscription{
+			Path: mustPath(t, "/interfaces/.../oper-status"),
+			Mode: gpb.SubscriptionMode_ON_CHANGE,
+		})
+		recvUpdates(t, sc)
+		update("/interfaces/interface[name=eth0]/state/counters/in-pkts", uintVal(10)) // Not subscribed.
+		update("/interfaces/interface[name=eth0]/state/oper-status", strVal("DOWN"))
+		n := recvNotification(t, sc)
+		want := []*gpb.Update{{Path: mustPath(t, "/interfaces/interface[name=eth0]/state/oper-status"), Val: strVal("DOWN")}}
+		if diff := cmp.Diff(want, n.GetUpdate(), protocmp.Transform()); diff != "" {
+			t.Errorf("Subscribe() got unexpected diff (-want,+got):\n%s", diff)
+		}
+		if err := g.Update(&gpb.Notification{Delete: []*gpb.Path{mustPath(t, "/interfaces/interface[name=eth0]")}}); err != nil {
+			t.Fatalf("Update() got error: %v", err)
+		}
+		n = recvNotification(t, sc)
+		if diff := cmp.Diff([]*gpb.Path{want[0].GetPath()}, n.GetDelete(), protocmp.Transform()); diff != "" {
+			t.Errorf("Subscribe() of delete got unexpected diff (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("sample", func(t *testing.T) {
+		update("/interfaces/interface[name=eth0]/state/counters/in-pkts", uintVal(10))
+		sc := subscribe(gpb.SubscriptionList_STREAM, &gpb.Subscription{
+			Path:           mustPath(t, "/interfaces/interface[name=eth0]/state/counters/in-pkts"),
+			Mode:           gpb.SubscriptionMode_SAMPLE,
+			SampleInterval: uint64(10 * time.Millisecond),
+		})
+		recvUpdates(t, sc)
+		for i := 0; i < 2; i++ {
+			n := recvNotification(t, sc)
+			want := []*gpb.Update{{Path: mustPath(t, "/interfaces/interface[name=eth0]/state/counters/in-pkts"), Val: uintVal(10)}}
+			if diff := cmp.Diff(want, n.GetUpdate(), protocmp.Transform()); diff != "" {
+				t.Errorf("Subscribe() sample %d got unexpected diff (-want,+got):\n%s", i, diff)
+			}
+		}
+	})
+}
+
+func TestSubscribe_MixedOrigins(t *testing.T) {
+	_, c := newTestGNMI(t)
+	sc, err := c.Subscribe(context.Background())
+	if err != nil {
+		t.Fatalf("Subscribe() got error: %v", err)
+	}
+	p := mustPath(t, "/interfaces/interface[name=eth1]/state/oper-status")
+	p.Origin = "openconfig"
+	if err := sc.Send(&gpb.SubscribeRequest{Request: &gpb.SubscribeRequest_Subscribe{Subscribe: &gpb.SubscriptionList{
+		Mode: gpb.SubscriptionList_ONCE,
+		Subscription: []*gpb.Subscription{
+			{Path: mustPath(t, "/interfaces/interface[name=eth0]/state/oper-status")},
+			{Path: p},
+		},
+	}}}); err != nil {
+		t.Fatalf("Send() got error: %v", err)
+	}
+	if _, err := sc.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Subscribe() with mixed origins got error %v, want code %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"/", "/interfaces/interface[name=eth0]/state/mtu", true},
+		{"/interfaces/interface[name=eth0]", "/interfaces/interface[name=eth0]/state/mtu", true},
+		{"/interfaces/interface[name=eth1]", "/interfaces/interface[name=eth0]/state/mtu", false},
+		{"/interfaces/interface[name=*]/state", "/interfaces/interface[name=eth0]/state/mtu", true},
+		{"/interfaces/interface/state", "/interfaces/interface[name=eth0]/state/mtu", true},
+		{"/interfaces/*/*/mtu", "/interfaces/interface[name=eth0]/state/mtu", true},
+		{"/.../mtu", "/interfaces/interface[name=eth0]/state/mtu", true},
+		{"/.../name", "/interfaces/interface[name=eth0]/state/mtu", false},
+		{"/interfaces/interface[name=eth0]/state/mtu/extra", "/interfaces/interface[name=eth0]/state/mtu", false},
+	}
+	for _, tt := range tests {
+		if got := matches(mustPath(t, tt.pattern).GetElem(), mustPath(t, tt.path).GetElem()); got != tt.want {
+			t.Errorf("matches(%s, %s) got %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
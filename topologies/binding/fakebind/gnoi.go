@@ -0,0 +1,441 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"io"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	fpb "github.com/openconfig/gnoi/file"
+	spb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// fileChunkSize is the size of the chunks in which files are sent.
+	fileChunkSize = 64 * 1024
+	// defaultPingCount is the number of pings of requests that do not specify it.
+	defaultPingCount = 5
+	// pingTime is the round trip time of every fake ping.
+	pingTime = time.Millisecond
+)
+
+// System is a fake gNOI System service.  Reboots set the boot time of the
+// system in the gNMI server, after their delay, but nothing else of the device
+// is affected.
+type System struct {
+	spb.UnimplementedSystemServer
+	gnmi *GNMI
+	file *File
+
+	mu      sync.Mutex
+	reboots []*spb.RebootRequest
+	kills   []*spb.KillProcessRequest
+	pending *spb.RebootRequest // The reboot that is waiting for its delay, if any.
+	timer   *time.Timer
+	when    time.Time // When the pending reboot will happen.
+	count   uint32    // Number of reboots that have happened.
+}
+
+// Reboots returns the reboot requests, in order.
+func (s *System) Reboots() []*spb.RebootRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.reboots)
+}
+
+// KillProcesses returns the kill process requests, in order.
+func (s *System) KillProcesses() []*spb.KillProcessRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.kills)
+}
+
+// Reboot implements the gNOI System Reboot RPC.
+func (s *System) Reboot(_ context.Context, req *spb.RebootRequest) (*spb.RebootResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pending != nil {
+		return nil, status.Error(codes.FailedPrecondition, "a reboot is already pending")
+	}
+	s.reboots = append(s.reboots, req)
+	if req.GetDelay() == 0 {
+		s.reboot()
+		return &spb.RebootResponse{}, nil
+	}
+	delay := time.Duration(req.GetDelay())
+	s.pending, s.when = req, time.Now().Add(delay)
+	s.timer = time.AfterFunc(delay, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.pending == req {
+			s.reboot()
+		}
+	})
+	return &spb.RebootResponse{}, nil
+}
+
+// reboot sets the boot time of the system.  It must be called with mu held.
+func (s *System) reboot() {
+	s.pending, s.timer = nil, nil
+	s.count++
+	now := time.Now().UnixNano()
+	n := &gpb.Notification{
+		Timestamp: now,
+		Update: []*gpb.Update{{
+			Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "system"}, {Name: "state"}, {Name: "boot-time"}}},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: uint64(now)}},
+		}},
+	}
+	if err := s.gnmi.Update(n); err != nil {
+		log.Warningf("Could not set the boot time after reboot: %v", err)
+	}
+}
+
+// RebootStatus implements the gNOI System RebootStatus RPC.
+func (s *System) RebootStatus(context.Context, *spb.RebootStatusRequest) (*spb.RebootStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &spb.RebootStatusResponse{
+		Count:  s.count,
+		Status: &spb.RebootStatus{Status: spb.RebootStatus_STATUS_SUCCESS},
+	}
+	if s.pending != nil {
+		resp.Active = true
+		resp.Wait = uint64(max(time.Until(s.when), 0))
+		resp.When = uint64(s.when.UnixNano())
+		resp.Reason = s.pending.GetMessage()
+		resp.Method = s.pending.GetMethod()
+	}
+	return resp, nil
+}
+
+// CancelReboot implements the gNOI System CancelReboot RPC.
+func (s *System) CancelReboot(context.Context, *spb.CancelRebootRequest) (*spb.CancelRebootResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.pending, s.timer = nil, nil
+	return &spb.CancelRebootResponse{}, nil
+}
+
+// KillProcess implements the gNOI System KillProcess RPC.
+func (s *System) KillProcess(_ context.Context, req *spb.KillProcessRequest) (*spb.KillProcessResponse, error) {
+	if req.GetPid() == 0 && req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "request has no pid or name")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.kills = append(s.kills, req)
+	return &spb.KillProcessResponse{}, nil
+}
+
+// Time implements the gNOI System Time RPC.
+func (s *System) Time(context.Context, *spb.TimeRequest) (*spb.TimeResponse, error) {
+	return &spb.TimeResponse{Time: uint64(time.Now().UnixNano())}, nil
+}
+
+// Ping implements the gNOI System Ping RPC.  Every ping of any destination is
+// answered at once.
+func (s *System) Ping(req *spb.PingRequest, stream spb.System_PingServer) error {
+	if req.GetDestination() == "" {
+		return status.Error(codes.InvalidArgument, "request has no destination")
+	}
+	count := req.GetCount()
+	if count == 0 {
+		count = defaultPingCount
+	}
+	size := req.GetSize()
+	if size == 0 {
+		size = 56
+	}
+	rtt := pingTime.Nanoseconds()
+	for i := int32(1); i <= count; i++ {
+		if err := stream.Send(&spb.PingResponse{
+			Source:   req.GetDestination(),
+			Time:     rtt,
+			Bytes:    size,
+			Sequence: i,
+		}); err != nil {
+			return err
+		}
+	}
+	return stream.Send(&spb.PingResponse{
+		Source:   req.GetDestination(),
+		Time:     int64(count) * rtt,
+		Sent:     count,
+		Received: count,
+		MinTime:  rtt,
+		AvgTime:  rtt,
+		MaxTime:  rtt,
+	})
+}
+
+// SwitchControlProcessor implements the gNOI System SwitchControlProcessor RPC.
+// It makes the component of the request the primary of its kind, and the other
+// components of the same redundant role secondary.
+func (s *System) SwitchControlProcessor(_ context.Context, req *spb.SwitchControlProcessorRequest) (*spb.SwitchControlProcessorResponse, error) {
+	elems := req.GetControlProcessor().GetElem()
+	if len(elems) != 2 || elems[0].GetName() != "components" || elems[1].GetName() != "component" || elems[1].GetKey()["name"] == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "control processor %v is not a component", req.GetControlProcessor())
+	}
+	name := elems[1].GetKey()["name"]
+	component := func(name string) []*gpb.PathElem {
+		return []*gpb.PathElem{{Name: "components"}, {Name: "component", Key: map[string]string{"name": name}}}
+	}
+	rolePath := func(name string) *gpb.Path {
+		return &gpb.Path{Elem: append(component(name), &gpb.PathElem{Name: "state"}, &gpb.PathElem{Name: "redundant-role"})}
+	}
+
+	g := s.gnmi
+	g.mu.Lock()
+	if len(g.leaves.match([][]*gpb.PathElem{component(name)}, nil)) == 0 {
+		g.mu.Unlock()
+		return nil, status.Errorf(codes.NotFound, "no component %q", name)
+	}
+	n := &gpb.Notification{Timestamp: time.Now().UnixNano()}
+	role := &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "SECONDARY"}}
+	for _, l := range g.leaves.match([][]*gpb.PathElem{rolePath("*").GetElem()}, nil) {
+		if proto.Equal(l.val, &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "PRIMARY"}}) {
+			n.Update = append(n.Update, &gpb.Update{Path: &gpb.Path{Elem: l.elems}, Val: role})
+		}
+	}
+	g.mu.Unlock()
+	n.Update = append(n.Update, &gpb.Update{
+		Path: rolePath(name),
+		Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "PRIMARY"}},
+	})
+	if err := g.Update(n); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not switch to %q: %v", name, err)
+	}
+	return &spb.SwitchControlProcessorResponse{
+		ControlProcessor: req.GetControlProcessor(),
+		Version:          "fake",
+	}, nil
+}
+
+// SetPackage implements the gNOI System SetPackage RPC.  The package is written
+// to the file service, and is not activated.
+func (s *System) SetPackage(stream spb.System_SetPackageServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	pkg := req.GetPackage()
+	if pkg == nil {
+		return status.Error(codes.InvalidArgument, "first request must be the package")
+	}
+	if pkg.GetRemoteDownload() != nil {
+		return status.Error(codes.Unimplemented, "remote download is not supported")
+	}
+	var contents bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return status.Error(codes.InvalidArgument, "stream ended without a hash")
+			}
+			return err
+		}
+		switch r := req.GetRequest().(type) {
+		case *spb.SetPackageRequest_Contents:
+			contents.Write(r.Contents)
+		case *spb.SetPackageRequest_Hash:
+			if err := checkHash(r.Hash, contents.Bytes()); err != nil {
+				return err
+			}
+			s.file.WriteFile(pkg.GetFilename(), contents.Bytes())
+			return stream.SendAndClose(&spb.SetPackageResponse{})
+		default:
+			return status.Errorf(codes.InvalidArgument, "unexpected request %T", r)
+		}
+	}
+}
+
+// File is a fake gNOI File service, which keeps files in memory.
+type File struct {
+	fpb.UnimplementedFileServer
+
+	mu    sync.Mutex
+	files map[string]*file
+}
+
+type file struct {
+	contents []byte
+	perms    uint32
+	modified time.Time
+}
+
+func newFile() *File {
+	return &File{files: make(map[string]*file)}
+}
+
+// WriteFile writes the file, as though it were put on the device.
+func (f *File) WriteFile(name string, contents []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.files[path.Clean(name)] = &file{contents: slices.Clone(contents), perms: 0644, modified: time.Now()}
+}
+
+// ReadFile returns the contents of the file, and whether it exists.
+func (f *File) ReadFile(name string) ([]byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fl, ok := f.files[path.Clean(name)]
+	if !ok {
+		return nil, false
+	}
+	return slices.Clone(fl.contents), true
+}
+
+// Put implements the gNOI File Put RPC.
+func (f *File) Put(stream fpb.File_PutServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	open := req.GetOpen()
+	if open == nil || open.GetRemoteFile() == "" {
+		return status.Error(codes.InvalidArgument, "first request must open a file")
+	}
+	var contents bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return status.Error(codes.InvalidArgument, "stream ended without a hash")
+			}
+			return err
+		}
+		switch r := req.GetRequest().(type) {
+		case *fpb.PutRequest_Contents:
+			contents.Write(r.Contents)
+		case *fpb.PutRequest_Hash:
+			if err := checkHash(r.Hash, contents.Bytes()); err != nil {
+				return err
+			}
+			f.mu.Lock()
+			f.files[path.Clean(open.GetRemoteFile())] = &file{
+				contents: contents.Bytes(),
+				perms:    open.GetPermissions(),
+				modified: time.Now(),
+			}
+			f.mu.Unlock()
+			return stream.SendAndClose(&fpb.PutResponse{})
+		default:
+			return status.Errorf(codes.InvalidArgument, "unexpected request %T", r)
+		}
+	}
+}
+
+// Get implements the gNOI File Get RPC.
+func (f *File) Get(req *fpb.GetRequest, stream fpb.File_GetServer) error {
+	contents, ok := f.ReadFile(req.GetRemoteFile())
+	if !ok {
+		return status.Errorf(codes.NotFound, "no file %q", req.GetRemoteFile())
+	}
+	for data := contents; len(data) > 0; {
+		n := min(len(data), fileChunkSize)
+		if err := stream.Send(&fpb.GetResponse{Response: &fpb.GetResponse_Contents{Contents: data[:n]}}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	sum := md5.Sum(contents)
+	return stream.Send(&fpb.GetResponse{Response: &fpb.GetResponse_Hash{
+		Hash: &tpb.HashType{Method: tpb.HashType_MD5, Hash: sum[:]},
+	}})
+}
+
+// Stat implements the gNOI File Stat RPC, for a file or the files directly in
+// a directory.
+func (f *File) Stat(_ context.Context, req *fpb.StatRequest) (*fpb.StatResponse, error) {
+	name := path.Clean(req.GetPath())
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stat := func(name string, fl *file) *fpb.StatInfo {
+		return &fpb.StatInfo{
+			Path:         name,
+			LastModified: uint64(fl.modified.UnixNano()),
+			Permissions:  fl.perms,
+			Size:         uint64(len(fl.contents)),
+		}
+	}
+	if fl, ok := f.files[name]; ok {
+		return &fpb.StatResponse{Stats: []*fpb.StatInfo{stat(name, fl)}}, nil
+	}
+	resp := new(fpb.StatResponse)
+	dir := strings.TrimSuffix(name, "/") + "/"
+	for n, fl := range f.files {
+		if strings.HasPrefix(n, dir) && !strings.Contains(n[len(dir):], "/") {
+			resp.Stats = append(resp.Stats, stat(n, fl))
+		}
+	}
+	if len(resp.Stats) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no file or directory %q", name)
+	}
+	sort.Slice(resp.Stats, func(i, j int) bool { return resp.Stats[i].GetPath() < resp.Stats[j].GetPath() })
+	return resp, nil
+}
+
+// Remove implements the gNOI File Remove RPC.
+func (f *File) Remove(_ context.Context, req *fpb.RemoveRequest) (*fpb.RemoveResponse, error) {
+	name := path.Clean(req.GetRemoteFile())
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.files[name]; !ok {
+		return nil, status.Errorf(codes.NotFound, "no file %q", name)
+	}
+	delete(f.files, name)
+	return &fpb.RemoveResponse{}, nil
+}
+
+// checkHash returns an error if the hash of the contents is not the one given.
+func checkHash(ht *tpb.HashType, contents []byte) error {
+	var h hash.Hash
+	switch ht.GetMethod() {
+	case tpb.HashType_MD5:
+		h = md5.New()
+	case tpb.HashType_SHA256:
+		h = sha256.New()
+	case tpb.HashType_SHA512:
+		h = sha512.New()
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported hash method %v", ht.GetMethod())
+	}
+	h.Write(contents)
+	if !bytes.Equal(h.Sum(nil), ht.GetHash()) {
+		return status.Errorf(codes.DataLoss, "%v hash of the contents does not match", ht.GetMethod())
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	fpb "github.com/openconfig/gnoi/file"
+	spb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
+)
+
+func newTestGNOI(t *testing.T) (*System, *File, *grpc.ClientConn, gpb.GNMIClient) {
+	t.Helper()
+	g := newGNMI(testSchema())
+	f := newFile()
+	s := &System{gnmi: g, file: f}
+	conn := serve(t, func(srv *grpc.Server) {
+		gpb.RegisterGNMIServer(srv, g)
+		spb.RegisterSystemServer(srv, s)
+		fpb.RegisterFileServer(srv, f)
+	})
+	return s, f, conn, gpb.NewGNMIClient(conn)
+}
+
+func TestReboot(t *testing.T) {
+	s, _, conn, gc := newTestGNOI(t)
+	c := spb.NewSystemClient(conn)
+	ctx := context.Background()
+	bootTime := func() uint64 {
+		t.Helper()
+		return getLeaves(t, gc, "/system/state/boot-time", gpb.GetRequest_ALL)["/system/state/boot-time"].GetUintVal()
+	}
+
+	before := uint64(time.Now().UnixNano())
+	if _, err := c.Reboot(ctx, &spb.RebootRequest{Method: spb.RebootMethod_COLD}); err != nil {
+		t.Fatalf("Reboot() got error: %v", err)
+	}
+	if got := bootTime(); got < before {
+		t.Errorf("Reboot() set boot time %d, want at least %d", got, before)
+	}
+
+	req := &spb.RebootRequest{Method: spb.RebootMethod_WARM, Delay: uint64(time.Hour), Message: "later"}
+	if _, err := c.Reboot(ctx, req); err != nil {
+		t.Fatalf("Reboot() with delay got error: %v", err)
+	}
+	if _, err := c.Reboot(ctx, req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Reboot() while pending got error %v, want code %v", err, codes.FailedPrecondition)
+	}
+	resp, err := c.RebootStatus(ctx, &spb.RebootStatusRequest{})
+	if err != nil {
+		t.Fatalf("RebootStatus() got error: %v", err)
+	}
+	if !resp.GetActive() || resp.GetReason() != "later" || resp.GetMethod() != spb.RebootMethod_WARM || resp.GetCount() != 1 {
+		t.Errorf("RebootStatus() got %v, want an active WARM reboot for reason %q after 1 reboot", resp, "later")
+	}
+	if _, err := c.CancelReboot(ctx, &spb.CancelRebootRequest{}); err != nil {
+		t.Fatalf("CancelReboot() got error: %v", err)
+	}
+	if resp, err := c.RebootStatus(ctx, &spb.RebootStatusRequest{}); err != nil || resp.GetActive() {
+		t.Errorf("RebootStatus() after cancel got %v, %v, want inactive", resp, err)
+	}
+	if got := len(s.Reboots()); got != 2 {
+		t.Errorf("Reboots() got %d requests, want 2", got)
+	}
+}
+
+func TestPing(t *testing.T) {
+	_, _, conn, _ := newTestGNOI(t)
+	pc, err := spb.NewSystemClient(conn).Ping(context.Background(), &spb.PingRequest{Destination: "192.0.2.1", Count: 3})
+	if err != nil {
+		t.Fatalf("Ping() got error: %v", err)
+	}
+	var resps []*spb.PingResponse
+	for {
+		resp, err := pc.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() got error: %v", err)
+		}
+		resps = append(resps, resp)
+	}
+	if len(resps) != 4 {
+		t.Fatalf("Ping() got %d responses, want 4", len(resps))
+	}
+	if last := resps[3]; last.GetSent() != 3 || last.GetReceived() != 3 {
+		t.Errorf("Ping() got summary %v, want 3 sent and received", last)
+	}
+}
+
+func TestSwitchControlProcessor(t *testing.T) {
+	s, _, conn, gc := newTestGNOI(t)
+	for _, name := range []string{"RP0", "RP1"} {
+		role := "SECONDARY"
+		if name == "RP0" {
+			role = "PRIMARY"
+		}
+		if err := s.gnmi.Update(&gpb.Notification{Update: []*gpb.Update{{
+			Path: mustPath(t, "/components/component[name="+name+"]/state/redundant-role"),
+			Val:  strVal(role),
+		}}}); err != nil {
+			t.Fatalf("Update() got error: %v", err)
+		}
+	}
+	c := spb.NewSystemClient(conn)
+	cp := &tpb.Path{Elem: []*tpb.PathElem{{Name: "components"}, {Name: "component", Key: map[string]string{"name": "RP1"}}}}
+	if _, err := c.SwitchControlProcessor(context.Background(), &spb.SwitchControlProcessorRequest{ControlProcessor: cp}); err != nil {
+		t.Fatalf("SwitchControlProcessor() got error: %v", err)
+	}
+	want := map[string]*gpb.TypedValue{
+		"/components/component[name=RP0]/state/redundant-role": strVal("SECONDARY"),
+		"/components/component[name=RP1]/state/redundant-role": strVal("PRIMARY"),
+	}
+	got := getLeaves(t, gc, "/components/component[name=*]/state/redundant-role", gpb.GetRequest_ALL)
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("SwitchControlProcessor() got unexpected diff of roles (-want,+got):\n%s", diff)
+	}
+
+	cp.Elem[1].Key["name"] = "RP2"
+	if _, err := c.SwitchControlProcessor(context.Background(), &spb.SwitchControlProcessorRequest{ControlProcessor: cp}); status.Code(err) != codes.NotFound {
+		t.Errorf("SwitchControlProcessor() of unknown component got error %v, want code %v", err, codes.NotFound)
+	}
+}
+
+func TestFile(t *testing.T) {
+	_, f, conn, _ := newTestGNOI(t)
+	c := fpb.NewFileClient(conn)
+	ctx := context.Background()
+	contents := bytes.Repeat([]byte("0123456789abcdef"), fileChunkSize/8) // Two chunks.
+	sum := sha256.Sum256(contents)
+
+	put := func(hash []byte) error {
+		t.Helper()
+		pc, err := c.Put(ctx)
+		if err != nil {
+			t.Fatalf("Put() got error: %v", err)
+		}
+		reqs := []*fpb.PutRequest{
+			{Request: &fpb.PutRequest_Open{Open: &fpb.PutRequest_Details{RemoteFile: "/tmp/file", Permissions: 0644}}},
+			{Request: &fpb.PutRequest_Contents{Contents: contents}},
+			{Request: &fpb.PutRequest_Hash{Hash: &tpb.HashType{Method: tpb.HashType_SHA256, Hash: hash}}},
+		}
+		for _, req := range reqs {
+			if err := pc.Send(req); err != nil {
+				t.Fatalf("Send() got error: %v", err)
+			}
+		}
+		_, err = pc.CloseAndRecv()
+		return err
+	}
+	if err := put([]byte("wrong")); status.Code(err) != codes.DataLoss {
+		t.Errorf("Put() with wrong hash got error %v, want code %v", err, codes.DataLoss)
+	}
+	if err := put(sum[:]); err != nil {
+		t.Fatalf("Put() got error: %v", err)
+	}
+	if got, ok := f.ReadFile("/tmp/file"); !ok || !bytes.Equal(got, contents) {
+		t.Errorf("ReadFile() after Put() got %d bytes, %v, want %d bytes", len(got), ok, len(contents))
+	}
+
+	gc, err := c.Get(ctx, &fpb.GetRequest{RemoteFile: "/tmp/file"})
+	if err != nil {
+		t.Fatalf("Get() got error: %v", err)
+	}
+	var got []byte
+	var chunks int
+	for {
+		resp, err := gc.Recv()
+		if err != nil {
+			t.Fatalf("Recv() got error: %v", err)
+		}
+		if h := resp.GetHash(); h != nil {
+			if err := checkHash(h, got); err != nil {
+				t.Errorf("Get() got bad hash: %v", err)
+			}
+			break
+		}
+		got = append(got, resp.GetContents()...)
+		chunks++
+	}
+	if !bytes.Equal(got, contents) || chunks != 2 {
+		t.Errorf("Get() got %d bytes in %d chunks, want %d bytes in 2 chunks", len(got), chunks, len(contents))
+	}
+
+	stat, err := c.Stat(ctx, &fpb.StatRequest{Path: "/tmp"})
+	if err != nil {
+		t.Fatalf("Stat() got error: %v", err)
+	}
+	if len(stat.GetStats()) != 1 || stat.GetStats()[0].GetPath() != "/tmp/file" || stat.GetStats()[0].GetSize() != uint64(len(contents)) {
+		t.Errorf("Stat() got %v, want /tmp/file of %d bytes", stat, len(contents))
+	}
+	if _, err := c.Remove(ctx, &fpb.RemoveRequest{RemoteFile: "/tmp/file"}); err != nil {
+		t.Fatalf("Remove() got error: %v", err)
+	}
+	if _, err := c.Remove(ctx, &fpb.RemoveRequest{RemoteFile: "/tmp/file"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Remove() of removed file got error %v, want code %v", err, codes.NotFound)
+	}
+}
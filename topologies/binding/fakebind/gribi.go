@@ -0,0 +1,403 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	aftpb "github.com/openconfig/gribi/v1/proto/gribi_aft"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// GRIBI is a fake gRIBI server, which keeps the AFT entries of each network
+// instance in memory.  Entries are checked for references to the next hops and
+// next-hop groups that they need, but are not reflected in gNMI.
+type GRIBI struct {
+	grpb.UnimplementedGRIBIServer
+
+	mu         sync.Mutex
+	params     *grpb.SessionParameters // Parameters of the connected clients.
+	clients    int                     // Number of connected clients.
+	electionID *grpb.Uint128           // Highest election ID, in SINGLE_PRIMARY.
+	entries    map[string]map[aftKey]*aftEntry
+}
+
+// aftKey identifies an AFT entry within its network instance.
+type aftKey struct {
+	aft grpb.AFTType
+	id  string
+}
+
+type aftEntry struct {
+	entry *grpb.AFTEntry
+	owner *gribiSession // Client that last programmed the entry.
+}
+
+// gribiSession is the state of a Modify stream.
+type gribiSession struct {
+	params     *grpb.SessionParameters
+	electionID *grpb.Uint128
+	modified   bool // Whether any operations have been received.
+}
+
+func newGRIBI() *GRIBI {
+	return &GRIBI{entries: make(map[string]map[aftKey]*aftEntry)}
+}
+
+// Entries returns the AFT entries of the network instance, sorted by kind and
+// ID.
+func (g *GRIBI) Entries(networkInstance string) []*grpb.AFTEntry {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sortedEntries(networkInstance, grpb.AFTType_ALL)
+}
+
+// Modify implements the gRIBI Modify RPC.
+func (g *GRIBI) Modify(stream grpb.GRIBI_ModifyServer) error {
+	sess := &gribiSession{params: new(grpb.SessionParameters)}
+	g.mu.Lock()
+	g.clients++
+	g.mu.Unlock()
+	defer g.disconnect(sess)
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resp, err := g.modify(sess, req)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// modify handles a request of a Modify stream.
+func (g *GRIBI) modify(sess *gribiSession, req *grpb.ModifyRequest) (*grpb.ModifyResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	resp := new(grpb.ModifyResponse)
+	if p := req.GetParams(); p != nil {
+		if sess.modified {
+			return nil, status.Error(codes.FailedPrecondition, "session parameters must be sent before any operations")
+		}
+		if g.params != nil && g.clients > 1 && !proto.Equal(g.params, p) {
+			return nil, status.Errorf(codes.FailedPrecondition, "session parameters %v differ from those of other clients %v", p, g.params)
+		}
+		sess.params, g.params = p, p
+		resp.SessionParamsResult = &grpb.SessionParametersResult{Status: grpb.SessionParametersResult_OK}
+	}
+	singlePrimary := sess.params.GetRedundancy() == grpb.SessionParameters_SINGLE_PRIMARY
+	if id := req.GetElectionId(); id != nil {
+		if !singlePrimary {
+			return nil, status.Error(codes.FailedPrecondition, "election ID requires SINGLE_PRIMARY redundancy")
+		}
+		sess.electionID = id
+		if g.electionID == nil || compareUint128(id, g.electionID) > 0 {
+			g.electionID = id
+		}
+		resp.ElectionId = g.electionID
+	}
+	for _, op := range req.GetOperation() {
+		sess.modified = true
+		ts := time.Now().UnixNano()
+		if singlePrimary && (op.GetElectionId() == nil || compareUint128(op.GetElectionId(), g.electionID) != 0) {
+			resp.Result = append(resp.Result, failed(op, ts, "operation is not from the primary client"))
+			continue
+		}
+		if err := g.apply(sess, op); err != nil {
+			resp.Result = append(resp.Result, failed(op, ts, err.Error()))
+			continue
+		}
+		resp.Result = append(resp.Result, &grpb.AFTResult{Id: op.GetId(), Status: grpb.AFTResult_RIB_PROGRAMMED, Timestamp: ts})
+		if sess.params.GetAckType() == grpb.SessionParameters_RIB_AND_FIB_ACK {
+			resp.Result = append(resp.Result, &grpb.AFTResult{Id: op.GetId(), Status: grpb.AFTResult_FIB_PROGRAMMED, Timestamp: ts})
+		}
+	}
+	return resp, nil
+}
+
+// disconnect removes the session, and its entries if they do not persist.
+func (g *GRIBI) disconnect(sess *gribiSession) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.clients--
+	if g.clients == 0 {
+		g.params, g.electionID = nil, nil
+	}
+	if sess.params.GetPersistence() != grpb.SessionParameters_DELETE {
+		return
+	}
+	for _, entries := range g.entries {
+		for k, e := range entries {
+			if e.owner == sess {
+				delete(entries, k)
+			}
+		}
+	}
+}
+
+// failed returns the result of the operation that failed.
+func failed(op *grpb.AFTOperation, ts int64, msg string) *grpb.AFTResult {
+	return &grpb.AFTResult{
+		Id:           op.GetId(),
+		Status:       grpb.AFTResult_FAILED,
+		Timestamp:    ts,
+		ErrorDetails: &grpb.AFTErrorDetails{ErrorMessage: msg},
+	}
+}
+
+// apply applies the operation to the entries.  It must be called with mu held.
+func (g *GRIBI) apply(sess *gribiSession, op *grpb.AFTOperation) error {
+	ni := op.GetNetworkInstance()
+	if ni == "" {
+		return fmt.Errorf("operation %d has no network instance", op.GetId())
+	}
+	entry := &grpb.AFTEntry{
+		NetworkInstance: ni,
+		RibStatus:       grpb.AFTEntry_PROGRAMMED,
+		FibStatus:       grpb.AFTEntry_PROGRAMMED,
+	}
+	switch e := op.GetEntry().(type) {
+	case *grpb.AFTOperation_NextHop:
+		entry.Entry = &grpb.AFTEntry_NextHop{NextHop: e.NextHop}
+	case *grpb.AFTOperation_NextHopGroup:
+		entry.Entry = &grpb.AFTEntry_NextHopGroup{NextHopGroup: e.NextHopGroup}
+	case *grpb.AFTOperation_Ipv4:
+		entry.Entry = &grpb.AFTEntry_Ipv4{Ipv4: e.Ipv4}
+	case *grpb.AFTOperation_Ipv6:
+		entry.Entry = &grpb.AFTEntry_Ipv6{Ipv6: e.Ipv6}
+	case *grpb.AFTOperation_Mpls:
+		entry.Entry = &grpb.AFTEntry_Mpls{Mpls: e.Mpls}
+	default:
+		return fmt.Errorf("unsupported entry %T", e)
+	}
+	key, err := entryKey(entry)
+	if err != nil {
+		return err
+	}
+	entries := g.entries[ni]
+	_, exists := entries[key]
+
+	switch op.GetOp() {
+	case grpb.AFTOperation_ADD, grpb.AFTOperation_REPLACE:
+		if op.GetOp() == grpb.AFTOperation_REPLACE && !exists {
+			return fmt.Errorf("cannot replace %s, which does not exist", key)
+		}
+		if err := g.checkRefs(entry); err != nil {
+			return err
+		}
+		if entries == nil {
+			entries = make(map[aftKey]*aftEntry)
+			g.entries[ni] = entries
+		}
+		entries[key] = &aftEntry{entry: entry, owner: sess}
+	case grpb.AFTOperation_DELETE:
+		if !exists {
+			return fmt.Errorf("cannot delete %s, which does not exist", key)
+		}
+		if ref := g.referrer(ni, key); ref != nil {
+			return fmt.Errorf("cannot delete %s, which %s refers to", key, ref)
+		}
+		delete(entries, key)
+	default:
+		return fmt.Errorf("unsupported operation %v", op.GetOp())
+	}
+	return nil
+}
+
+func (k aftKey) String() string {
+	return fmt.Sprintf("%v %s", k.aft, k.id)
+}
+
+// entryKey returns the key of the entry.
+func entryKey(e *grpb.AFTEntry) (aftKey, error) {
+	switch {
+	case e.GetNextHop() != nil:
+		return aftKey{grpb.AFTType_NEXTHOP, strconv.FormatUint(e.GetNextHop().GetIndex(), 10)}, nil
+	case e.GetNextHopGroup() != nil:
+		return aftKey{grpb.AFTType_NEXTHOP_GROUP, strconv.FormatUint(e.GetNextHopGroup().GetId(), 10)}, nil
+	case e.GetIpv4() != nil:
+		return aftKey{grpb.AFTType_IPV4, e.GetIpv4().GetPrefix()}, nil
+	case e.GetIpv6() != nil:
+		return aftKey{grpb.AFTType_IPV6, e.GetIpv6().GetPrefix()}, nil
+	case e.GetMpls() != nil:
+		label, ok := e.GetMpls().GetLabel().(*aftpb.Afts_LabelEntryKey_LabelUint64)
+		if !ok {
+			return aftKey{}, fmt.Errorf("unsupported MPLS label %v", e.GetMpls().GetLabel())
+		}
+		return aftKey{grpb.AFTType_MPLS, strconv.FormatUint(label.LabelUint64, 10)}, nil
+	}
+	return aftKey{}, fmt.Errorf("entry %v has no key", e)
+}
+
+// refs returns the keys and network instances of the entries that the entry
+// refers to.
+func refs(e *grpb.AFTEntry) map[aftKey]string {
+	ni := e.GetNetworkInstance()
+	nhg := func(id uint64, nhgNI string) map[aftKey]string {
+		if nhgNI == "" {
+			nhgNI = ni
+		}
+		return map[aftKey]string{{grpb.AFTType_NEXTHOP_GROUP, strconv.FormatUint(id, 10)}: nhgNI}
+	}
+	switch {
+	case e.GetNextHopGroup() != nil:
+		group := e.GetNextHopGroup().GetNextHopGroup()
+		r := make(map[aftKey]string)
+		for _, nh := range group.GetNextHop() {
+			r[aftKey{grpb.AFTType_NEXTHOP, strconv.FormatUint(nh.GetIndex(), 10)}] = ni
+		}
+		if b := group.GetBackupNextHopGroup(); b != nil {
+			r[aftKey{grpb.AFTType_NEXTHOP_GROUP, strconv.FormatUint(b.GetValue(), 10)}] = ni
+		}
+		return r
+	case e.GetIpv4() != nil:
+		ie := e.GetIpv4().GetIpv4Entry()
+		return nhg(ie.GetNextHopGroup().GetValue(), ie.GetNextHopGroupNetworkInstance().GetValue())
+	case e.GetIpv6() != nil:
+		ie := e.GetIpv6().GetIpv6Entry()
+		return nhg(ie.GetNextHopGroup().GetValue(), ie.GetNextHopGroupNetworkInstance().GetValue())
+	case e.GetMpls() != nil:
+		le := e.GetMpls().GetLabelEntry()
+		return nhg(le.GetNextHopGroup().GetValue(), le.GetNextHopGroupNetworkInstance().GetValue())
+	}
+	return nil
+}
+
+// checkRefs returns an error if an entry that the entry refers to does not
+// exist.  It must be called with mu held.
+func (g *GRIBI) checkRefs(e *grpb.AFTEntry) error {
+	for key, ni := range refs(e) {
+		if _, ok := g.entries[ni][key]; !ok {
+			return fmt.Errorf("%s of network instance %q does not exist", key, ni)
+		}
+	}
+	return nil
+}
+
+// referrer returns the key of an entry that refers to the entry of the key in
+// the network instance, or nil if there is none.  It must be called with mu
+// held.
+func (g *GRIBI) referrer(ni string, key aftKey) *aftKey {
+	for _, entries := range g.entries {
+		for k, e := range entries {
+			if refNI, ok := refs(e.entry)[key]; ok && refNI == ni {
+				return &k
+			}
+		}
+	}
+	return nil
+}
+
+// Get implements the gRIBI Get RPC.
+func (g *GRIBI) Get(req *grpb.GetRequest, stream grpb.GRIBI_GetServer) error {
+	g.mu.Lock()
+	var nis []string
+	switch ni := req.GetNetworkInstance().(type) {
+	case *grpb.GetRequest_Name:
+		nis = []string{ni.Name}
+	case *grpb.GetRequest_All:
+		for name := range g.entries {
+			nis = append(nis, name)
+		}
+		sort.Strings(nis)
+	default:
+		g.mu.Unlock()
+		return status.Error(codes.InvalidArgument, "request has no network instance")
+	}
+	resp := new(grpb.GetResponse)
+	for _, ni := range nis {
+		resp.Entry = append(resp.Entry, g.sortedEntries(ni, req.GetAft())...)
+	}
+	g.mu.Unlock()
+	return stream.Send(resp)
+}
+
+// sortedEntries returns the entries of the network instance and AFT type,
+// sorted by type and ID.  It must be called with mu held.
+func (g *GRIBI) sortedEntries(ni string, aft grpb.AFTType) []*grpb.AFTEntry {
+	var keys []aftKey
+	for k := range g.entries[ni] {
+		if aft == grpb.AFTType_ALL || aft == k.aft {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].aft != keys[j].aft {
+			return keys[i].aft < keys[j].aft
+		}
+		return keys[i].id < keys[j].id
+	})
+	var entries []*grpb.AFTEntry
+	for _, k := range keys {
+		entries = append(entries, g.entries[ni][k].entry)
+	}
+	return entries
+}
+
+// Flush implements the gRIBI Flush RPC.
+func (g *GRIBI) Flush(_ context.Context, req *grpb.FlushRequest) (*grpb.FlushResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	singlePrimary := g.params.GetRedundancy() == grpb.SessionParameters_SINGLE_PRIMARY
+	switch e := req.GetElection().(type) {
+	case *grpb.FlushRequest_Id:
+		if !singlePrimary {
+			return nil, status.Error(codes.FailedPrecondition, "election ID requires SINGLE_PRIMARY redundancy")
+		}
+		if g.electionID != nil && compareUint128(e.Id, g.electionID) < 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "election ID %v is lower than that of the primary %v", e.Id, g.electionID)
+		}
+	case *grpb.FlushRequest_Override:
+	default:
+		if singlePrimary {
+			return nil, status.Error(codes.FailedPrecondition, "request has no election ID or override")
+		}
+	}
+	switch ni := req.GetNetworkInstance().(type) {
+	case *grpb.FlushRequest_Name:
+		delete(g.entries, ni.Name)
+	case *grpb.FlushRequest_All:
+		clear(g.entries)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "request has no network instance")
+	}
+	return &grpb.FlushResponse{Timestamp: time.Now().UnixNano(), Result: grpb.FlushResponse_OK}, nil
+}
+
+// compareUint128 compares the 128-bit integers, like cmp.Compare.
+func compareUint128(a, b *grpb.Uint128) int {
+	if c := cmp.Compare(a.GetHigh(), b.GetHigh()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.GetLow(), b.GetLow())
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ygot/proto/ywrapper"
+	"google.golang.org/grpc"
+
+	aftpb "github.com/openconfig/gribi/v1/proto/gribi_aft"
+	grpb "github.com/openconfig/gribi/v1/proto/service"
+)
+
+func nhOp(id, index uint64, op grpb.AFTOperation_Operation) *grpb.AFTOperation {
+	return &grpb.AFTOperation{
+		Id:              id,
+		NetworkInstance: "DEFAULT",
+		Op:              op,
+		Entry: &grpb.AFTOperation_NextHop{NextHop: &aftpb.Afts_NextHopKey{
+			Index:   index,
+			NextHop: &aftpb.Afts_NextHop{IpAddress: &ywrapper.StringValue{Value: "192.0.2.1"}},
+		}},
+	}
+}
+
+func nhgOp(id, nhgID, index uint64, op grpb.AFTOperation_Operation) *grpb.AFTOperation {
+	return &grpb.AFTOperation{
+		Id:              id,
+		NetworkInstance: "DEFAULT",
+		Op:              op,
+		Entry: &grpb.AFTOperation_NextHopGroup{NextHopGroup: &aftpb.Afts_NextHopGroupKey{
+			Id: nhgID,
+			NextHopGroup: &aftpb.Afts_NextHopGroup{
+				NextHop: []*aftpb.Afts_NextHopGroup_NextHopKey{{Index: index}},
+			},
+		}},
+	}
+}
+
+func ipv4Op(id uint64, prefix string, nhgID uint64, op grpb.AFTOperation_Operation) *grpb.AFTOperation {
+	return &grpb.AFTOperation{
+		Id:              id,
+		NetworkInstance: "DEFAULT",
+		Op:              op,
+		Entry: &grpb.AFTOperation_Ipv4{Ipv4: &aftpb.Afts_Ipv4EntryKey{
+			Prefix:    prefix,
+			Ipv4Entry: &aftpb.Afts_Ipv4Entry{NextHopGroup: &ywrapper.UintValue{Value: nhgID}},
+		}},
+	}
+}
+
+// modify sends the request on the stream, and returns the statuses of the
+// results by operation ID.
+func modify(t *testing.T, mc grpb.GRIBI_ModifyClient, req *grpb.ModifyRequest) map[uint64][]grpb.AFTResult_Status {
+	t.Helper()
+	if err := mc.Send(req); err != nil {
+		t.Fatalf("Send() got error: %v", err)
+	}
+	resp, err := mc.Recv()
+	if err != nil {
+		t.Fatalf("Recv() got error: %v", err)
+	}
+	got := make(map[uint64][]grpb.AFTResult_Status)
+	for _, r := range resp.GetResult() {
+		got[r.GetId()] = append(got[r.GetId()], r.GetStatus())
+	}
+	return got
+}
+
+func TestModify(t *testing.T) {
+	g := newGRIBI()
+	conn := serve(t, func(s *grpc.Server) { grpb.RegisterGRIBIServer(s, g) })
+	c := grpb.NewGRIBIClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mc, err := c.Modify(ctx)
+	if err != nil {
+		t.Fatalf("Modify() got error: %v", err)
+	}
+
+	modify(t, mc, &grpb.ModifyRequest{Params: &grpb.SessionParameters{
+		Redundancy:  grpb.SessionParameters_SINGLE_PRIMARY,
+		Persistence: grpb.SessionParameters_PRESERVE,
+		AckType:     grpb.SessionParameters_RIB_AND_FIB_ACK,
+	}})
+	election := &grpb.Uint128{Low: 2}
+	modify(t, mc, &grpb.ModifyRequest{ElectionId: election})
+
+	var ops []*grpb.AFTOperation
+	for _, op := range []*grpb.AFTOperation{
+		ipv4Op(1, "198.51.100.0/24", 10, grpb.AFTOperation_ADD), // Missing next-hop group.
+		nhOp(2, 1, grpb.AFTOperation_ADD),
+		nhgOp(3, 10, 1, grpb.AFTOperation_ADD),
+		ipv4Op(4, "198.51.100.0/24", 10, grpb.AFTOperation_ADD),
+		nhOp(5, 1, grpb.AFTOperation_DELETE), // Still referenced.
+	} {
+		op.ElectionId = election
+		ops = append(ops, op)
+	}
+	stale := nhOp(6, 2, grpb.AFTOperation_ADD)
+	stale.ElectionId = &grpb.Uint128{Low: 1}
+	ops = append(ops, stale)
+
+	got := modify(t, mc, &grpb.ModifyRequest{Operation: ops})
+	programmed := []grpb.AFTResult_Status{grpb.AFTResult_RIB_PROGRAMMED, grpb.AFTResult_FIB_PROGRAMMED}
+	failed := []grpb.AFTResult_Status{grpb.AFTResult_FAILED}
+	want := map[uint64][]grpb.AFTResult_Status{
+		1: failed,
+		2: programmed,
+		3: programmed,
+		4: programmed,
+		5: failed,
+		6: failed,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Modify() got unexpected diff of results (-want,+got):\n%s", diff)
+	}
+
+	gc, err := c.Get(ctx, &grpb.GetRequest{
+		NetworkInstance: &grpb.GetRequest_Name{Name: "DEFAULT"},
+		Aft:             grpb.AFTType_ALL,
+	})
+	if err != nil {
+		t.Fatalf("Get() got error: %v", err)
+	}
+	resp, err := gc.Recv()
+	if err != nil {
+		t.Fatalf("Recv() got error: %v", err)
+	}
+	if got := len(resp.GetEntry()); got != 3 {
+		t.Errorf("Get() got %d entries, want 3", got)
+	}
+
+	if _, err := c.Flush(ctx, &grpb.FlushRequest{
+		Election:        &grpb.FlushRequest_Id{Id: &grpb.Uint128{Low: 1}},
+		NetworkInstance: &grpb.FlushRequest_All{All: &grpb.Empty{}},
+	}); err == nil {
+		t.Errorf("Flush() with a stale election ID got no error, want error")
+	}
+	if _, err := c.Flush(ctx, &grpb.FlushRequest{
+		Election:        &grpb.FlushRequest_Id{Id: election},
+		NetworkInstance: &grpb.FlushRequest_Name{Name: "DEFAULT"},
+	}); err != nil {
+		t.Fatalf("Flush() got error: %v", err)
+	}
+	if got := g.Entries("DEFAULT"); len(got) != 0 {
+		t.Errorf("Entries() after Flush() got %v, want none", got)
+	}
+}
+
+func TestModify_DeletePersistence(t *testing.T) {
+	g := newGRIBI()
+	conn := serve(t, func(s *grpc.Server) { grpb.RegisterGRIBIServer(s, g) })
+	ctx, cancel := context.WithCancel(context.Background())
+	mc, err := grpb.NewGRIBIClient(conn).Modify(ctx)
+	if err != nil {
+		t.Fatalf("Modify() got error: %v", err)
+	}
+	got := modify(t, mc, &grpb.ModifyRequest{Operation: []*grpb.AFTOperation{nhOp(1, 1, grpb.AFTOperation_ADD)}})
+	if diff := cmp.Diff(map[uint64][]grpb.AFTResult_Status{1: {grpb.AFTResult_RIB_PROGRAMMED}}, got); diff != "" {
+		t.Errorf("Modify() got unexpected diff of results (-want,+got):\n%s", diff)
+	}
+	if got := len(g.Entries("DEFAULT")); got != 1 {
+		t.Fatalf("Entries() got %d entries, want 1", got)
+	}
+	cancel()
+	// The entries of the client go when its stream ends.
+	deadline := time.Now().Add(10 * time.Second)
+	for len(g.Entries("DEFAULT")) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Entries() of the disconnected client were not deleted")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,659 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakebind
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// schema checks the paths and values of a device against a YANG schema.
+type schema struct {
+	root *yang.Entry // Fake root, whose children are the top-level nodes.
+}
+
+// node is the schema entry of a path, with the entries of its ancestors, from
+// which leafrefs are resolved.
+type node struct {
+	entry     *yang.Entry
+	ancestors []*yang.Entry // From the root to the parent of the entry.
+	readOnly  bool          // Whether the node is state, rather than config.
+}
+
+// child returns the node of the child entry of the node.
+func (n *node) child(e *yang.Entry) *node {
+	return &node{
+		entry:     e,
+		ancestors: append(slices.Clip(n.ancestors), n.entry),
+		readOnly:  n.readOnly || e.Config == yang.TSFalse,
+	}
+}
+
+// leaf is a leaf or leaf-list of the datastore of a device.
+type leaf struct {
+	elems    []*gpb.PathElem
+	val      *gpb.TypedValue
+	ts       int64         // Nanoseconds since the epoch when it was set.
+	kind     yang.TypeKind // Kind of its type, with leafrefs resolved.
+	readOnly bool          // Whether the schema makes it state, rather than config.
+	derived  bool          // Whether it is state that reflects config, and goes with it.
+}
+
+func isContainer(e *yang.Entry) bool { return e.Kind == yang.DirectoryEntry && e.ListAttr == nil }
+func isList(e *yang.Entry) bool      { return e.Kind == yang.DirectoryEntry && e.ListAttr != nil }
+func isLeaf(e *yang.Entry) bool      { return e.Kind == yang.LeafEntry && e.ListAttr == nil }
+func isLeafList(e *yang.Entry) bool  { return e.Kind == yang.LeafEntry && e.ListAttr != nil }
+
+// stripPrefix strips the module or prefix, if any, from a name or identity.
+func stripPrefix(name string) string {
+	return name[strings.IndexByte(name, ':')+1:]
+}
+
+// childEntry returns the child of the entry with the name, looking through the
+// choice and case statements, which are not data nodes.
+func childEntry(e *yang.Entry, name string) *yang.Entry {
+	name = stripPrefix(name)
+	if c, ok := e.Dir[name]; ok && c.Kind != yang.ChoiceEntry && c.Kind != yang.CaseEntry {
+		return c
+	}
+	for _, c := range e.Dir {
+		if c.Kind == yang.ChoiceEntry || c.Kind == yang.CaseEntry {
+			if cc := childEntry(c, name); cc != nil {
+				return cc
+			}
+		}
+	}
+	return nil
+}
+
+// lookup returns the node of the path.  With wildcards, the keys of lists may
+// be "*" or missing, and the node is nil after an element "*" or "...", from
+// which the path is not checked.  Without wildcards, only the last element may
+// be a list without keys, which is the whole list.
+func (s *schema) lookup(elems []*gpb.PathElem, wildcards bool) (*node, error) {
+	n := &node{entry: s.root}
+	for i, elem := range elems {
+		if wildcards && (elem.GetName() == "*" || elem.GetName() == "...") {
+			return nil, nil
+		}
+		if n.entry.Kind != yang.DirectoryEntry {
+			return nil, fmt.Errorf("%s is not a container or list", pathString(elems[:i]))
+		}
+		c := childEntry(n.entry, elem.GetName())
+		if c == nil {
+			return nil, fmt.Errorf("%s has no element %q", pathString(elems[:i]), elem.GetName())
+		}
+		if err := checkKeys(c, elem, wildcards || i == len(elems)-1, wildcards); err != nil {
+			return nil, fmt.Errorf("%s: %w", pathString(elems[:i+1]), err)
+		}
+		n = n.child(c)
+	}
+	return n, nil
+}
+
+// checkKeys checks the keys of the path element of the entry.
+func checkKeys(e *yang.Entry, elem *gpb.PathElem, missingOK, wildcards bool) error {
+	keys := elem.GetKey()
+	if !isList(e) {
+		if len(keys) > 0 {
+			return fmt.Errorf("%s is not a list but has keys", e.Name)
+		}
+		return nil
+	}
+	if len(keys) == 0 {
+		if missingOK {
+			return nil
+		}
+		return fmt.Errorf("list %s has no keys", e.Name)
+	}
+	names := strings.Fields(e.Key)
+	for k := range keys {
+		if !slices.Contains(names, k) {
+			return fmt.Errorf("list %s has no key %q, want keys %v", e.Name, k, names)
+		}
+	}
+	for _, k := range names {
+		v, ok := keys[k]
+		switch {
+		case !ok && !wildcards:
+			return fmt.Errorf("list %s is missing key %q", e.Name, k)
+		case v == "*" && !wildcards:
+			return fmt.Errorf("list %s has a wildcard for key %q", e.Name, k)
+		}
+	}
+	return nil
+}
+
+// leafType returns the type of the leaf or leaf-list node, with leafrefs
+// resolved if possible.
+func (s *schema) leafType(n *node) *yang.YangType {
+	t := n.entry.Type
+	for i := 0; t != nil && t.Kind == yang.Yleafref && i < 10; i++ {
+		target := resolve(n, t.Path)
+		if target == nil || target.entry.Type == nil {
+			break
+		}
+		n, t = target, target.entry.Type
+	}
+	if t == nil {
+		return &yang.YangType{Kind: yang.Ynone}
+	}
+	return t
+}
+
+// predicateRE matches the predicates of a leafref path.
+var predicateRE = regexp.MustCompile(`\[[^\]]*\]`)
+
+// resolve returns the node of the leafref path from the node, or nil if it
+// cannot be found.
+func resolve(n *node, path string) *node {
+	path = predicateRE.ReplaceAllString(path, "")
+	stack := append(slices.Clip(n.ancestors), n.entry)
+	if strings.HasPrefix(path, "/") {
+		stack = stack[:1]
+	}
+	for _, part := range strings.Split(path, "/") {
+		switch part {
+		case "", ".":
+		case "..":
+			if len(stack) == 1 {
+				return nil
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			c := childEntry(stack[len(stack)-1], part)
+			if c == nil {
+				return nil
+			}
+			stack = append(stack, c)
+		}
+	}
+	return &node{entry: stack[len(stack)-1], ancestors: stack[:len(stack)-1]}
+}
+
+// leaves returns the leaves of the value at the path, which is JSON for any
+// node, or a scalar for a leaf or leaf-list.
+func (s *schema) leaves(elems []*gpb.PathElem, val *gpb.TypedValue) ([]*leaf, error) {
+	n, err := s.lookup(elems, false)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	switch v := val.GetValue().(type) {
+	case *gpb.TypedValue_JsonIetfVal:
+		data = v.JsonIetfVal
+	case *gpb.TypedValue_JsonVal:
+		data = v.JsonVal
+	default:
+		l, err := s.scalarLeaf(n, elems, val)
+		if err != nil {
+			return nil, err
+		}
+		return []*leaf{l}, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid JSON value of %s: %w", pathString(elems), err)
+	}
+	var ls []*leaf
+	if err := s.flatten(n, elems, v, &ls); err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+// scalarLeaf returns the leaf of the scalar value at the path of the node, as
+// the type of the leaf.
+func (s *schema) scalarLeaf(n *node, elems []*gpb.PathElem, val *gpb.TypedValue) (*leaf, error) {
+	t := s.leafType(n)
+	l := &leaf{elems: elems, kind: t.Kind, readOnly: n.readOnly}
+	switch {
+	case isLeaf(n.entry):
+		tv, err := convert(t, val)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pathString(elems), err)
+		}
+		l.val = tv
+	case isLeafList(n.entry):
+		ll := val.GetLeaflistVal()
+		if ll == nil {
+			return nil, fmt.Errorf("%s is a leaf-list, but the value is %T", pathString(elems), val.GetValue())
+		}
+		var tvs []*gpb.TypedValue
+		for _, e := range ll.GetElement() {
+			tv, err := convert(t, e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", pathString(elems), err)
+			}
+			tvs = append(tvs, tv)
+		}
+		l.val = &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: &gpb.ScalarArray{Element: tvs}}}
+	default:
+		return nil, fmt.Errorf("%s is not a leaf, so its value must be JSON", pathString(elems))
+	}
+	return l, nil
+}
+
+// flatten appends the leaves of the JSON value of the node at the path.
+func (s *schema) flatten(n *node, elems []*gpb.PathElem, v any, out *[]*leaf) error {
+	e := n.entry
+	switch {
+	case isLeaf(e):
+		t := s.leafType(n)
+		tv, err := fromJSON(t, v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pathString(elems), err)
+		}
+		*out = append(*out, &leaf{elems: elems, val: tv, kind: t.Kind, readOnly: n.readOnly})
+	case isLeafList(e):
+		t := s.leafType(n)
+		arr, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%s is a leaf-list, but the value is not a JSON array", pathString(elems))
+		}
+		var tvs []*gpb.TypedValue
+		for _, item := range arr {
+			tv, err := fromJSON(t, item)
+			if err != nil {
+				return fmt.Errorf("%s: %w", pathString(elems), err)
+			}
+			tvs = append(tvs, tv)
+		}
+		tv := &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: &gpb.ScalarArray{Element: tvs}}}
+		*out = append(*out, &leaf{elems: elems, val: tv, kind: t.Kind, readOnly: n.readOnly})
+	case isList(e) && len(elems[len(elems)-1].GetKey()) == 0:
+		arr, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%s is a list, but the value is not a JSON array", pathString(elems))
+		}
+		for _, item := range arr {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s has an entry that is not a JSON object", pathString(elems))
+			}
+			keys, err := s.entryKeys(n, obj)
+			if err != nil {
+				return fmt.Errorf("%s: %w", pathString(elems), err)
+			}
+			entry := append(slices.Clip(elems[:len(elems)-1]), &gpb.PathElem{Name: e.Name, Key: keys})
+			if err := s.flattenDir(n, entry, obj, out); err != nil {
+				return err
+			}
+		}
+	default:
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s is not a leaf, but the value is not a JSON object", pathString(elems))
+		}
+		return s.flattenDir(n, elems, obj, out)
+	}
+	return nil
+}
+
+// flattenDir appends the leaves of the JSON object of the container or list
+// entry at the path.
+func (s *schema) flattenDir(n *node, elems []*gpb.PathElem, obj map[string]any, out *[]*leaf) error {
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if strings.HasPrefix(name, "@") {
+			continue // Metadata.
+		}
+		c := childEntry(n.entry, name)
+		if c == nil {
+			return fmt.Errorf("%s has no element %q", pathString(elems), name)
+		}
+		celems := append(slices.Clip(elems), &gpb.PathElem{Name: c.Name})
+		if err := s.flatten(n.child(c), celems, obj[name], out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entryKeys returns the keys of the JSON object of an entry of the list.
+func (s *schema) entryKeys(n *node, obj map[string]any) (map[string]string, error) {
+	keys := make(map[string]string)
+	for _, k := range strings.Fields(n.entry.Key) {
+		c := childEntry(n.entry, k)
+		if c == nil {
+			return nil, fmt.Errorf("list has no key leaf %q", k)
+		}
+		var v any
+		for name, val := range obj {
+			if stripPrefix(name) == k {
+				v = val
+			}
+		}
+		if v == nil {
+			return nil, fmt.Errorf("entry has no key %q", k)
+		}
+		tv, err := fromJSON(s.leafType(n.child(c)), v)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k, err)
+		}
+		if keys[k], err = scalarString(tv); err != nil {
+			return nil, fmt.Errorf("key %q: %w", k, err)
+		}
+	}
+	return keys, nil
+}
+
+// keyLeaf returns the leaf of the key of the list entry at the path.
+func (s *schema) keyLeaf(entry []*gpb.PathElem, key string) (*leaf, error) {
+	elems := append(slices.Clip(entry), &gpb.PathElem{Name: key})
+	n, err := s.lookup(elems, false)
+	if err != nil {
+		return nil, err
+	}
+	t := s.leafType(n)
+	tv, err := fromString(t, entry[len(entry)-1].GetKey()[key])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", pathString(elems), err)
+	}
+	return &leaf{elems: elems, val: tv, kind: t.Kind, readOnly: n.readOnly}, nil
+}
+
+// stateLeaf returns the state leaf that reflects the config leaf, if any: the
+// leaf of the same name in the state container beside its config container.
+func (s *schema) stateLeaf(l *leaf) *leaf {
+	elems := stateElems(l.elems)
+	if elems == nil {
+		return nil
+	}
+	n, err := s.lookup(elems, false)
+	if err != nil || !n.readOnly || n.entry.Kind != yang.LeafEntry {
+		return nil
+	}
+	return &leaf{elems: elems, val: l.val, ts: l.ts, kind: l.kind, readOnly: true, derived: true}
+}
+
+// stateElems returns the path with its last "config" element replaced by
+// "state", or nil if it has none.
+func stateElems(elems []*gpb.PathElem) []*gpb.PathElem {
+	for i := len(elems) - 2; i >= 0; i-- {
+		if elems[i].GetName() == "config" && len(elems[i].GetKey()) == 0 {
+			state := slices.Clone(elems)
+			state[i] = &gpb.PathElem{Name: "state"}
+			return state
+		}
+	}
+	return nil
+}
+
+// intBits are the sizes of the integer types.
+var intBits = map[yang.TypeKind]int{
+	yang.Yint8: 8, yang.Yint16: 16, yang.Yint32: 32, yang.Yint64: 64,
+	yang.Yuint8: 8, yang.Yuint16: 16, yang.Yuint32: 32, yang.Yuint64: 64,
+}
+
+func isNumeric(k yang.TypeKind) bool {
+	_, ok := intBits[k]
+	return ok || k == yang.Ydecimal64
+}
+
+// fromString returns the value of the type from its string form, as in the
+// key of a path.
+func fromString(t *yang.YangType, s string) (*gpb.TypedValue, error) {
+	switch t.Kind {
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yint64:
+		i, err := strconv.ParseInt(s, 10, intBits[t.Kind])
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", s, t.Name)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_IntVal{IntVal: i}}, nil
+	case yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Yuint64:
+		u, err := strconv.ParseUint(s, 10, intBits[t.Kind])
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", s, t.Name)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: u}}, nil
+	case yang.Ydecimal64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", s, t.Name)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_DoubleVal{DoubleVal: f}}, nil
+	case yang.Ybool, yang.Yempty:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s", s, t.Name)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: b}}, nil
+	case yang.Ybinary:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not valid base64 for %s", s, t.Name)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_BytesVal{BytesVal: b}}, nil
+	case yang.Yunion:
+		for _, m := range t.Type {
+			if tv, err := fromString(m, s); err == nil {
+				return tv, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a valid %s", s, t.Name)
+	case yang.Yidentityref:
+		return &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: stripPrefix(s)}}, nil
+	default:
+		return &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: s}}, nil
+	}
+}
+
+// fromJSON returns the value of the type from its RFC 7951 JSON value.
+func fromJSON(t *yang.YangType, v any) (*gpb.TypedValue, error) {
+	if t.Kind == yang.Yunion {
+		for _, m := range t.Type {
+			if tv, err := fromJSON(m, v); err == nil {
+				return tv, nil
+			}
+		}
+		return nil, fmt.Errorf("%v is not a valid %s", v, t.Name)
+	}
+	unresolved := t.Kind == yang.Yleafref || t.Kind == yang.Ynone
+	switch v := v.(type) {
+	case string:
+		// 64-bit numbers are strings in RFC 7951, and smaller ones are not.
+		if isNumeric(t.Kind) && intBits[t.Kind] < 64 && t.Kind != yang.Ydecimal64 {
+			return nil, fmt.Errorf("%q is a string, not a %s", v, t.Name)
+		}
+		if t.Kind == yang.Ybool || t.Kind == yang.Yempty {
+			return nil, fmt.Errorf("%q is a string, not a %s", v, t.Name)
+		}
+		return fromString(t, v)
+	case json.Number:
+		switch {
+		case isNumeric(t.Kind):
+			return fromString(t, v.String())
+		case unresolved && strings.ContainsAny(v.String(), ".eE"):
+			return fromString(&yang.YangType{Name: "decimal64", Kind: yang.Ydecimal64}, v.String())
+		case unresolved && strings.HasPrefix(v.String(), "-"):
+			return fromString(&yang.YangType{Name: "int64", Kind: yang.Yint64}, v.String())
+		case unresolved:
+			return fromString(&yang.YangType{Name: "uint64", Kind: yang.Yuint64}, v.String())
+		}
+		return nil, fmt.Errorf("%v is a number, not a %s", v, t.Name)
+	case bool:
+		if t.Kind != yang.Ybool && !unresolved {
+			return nil, fmt.Errorf("%v is a boolean, not a %s", v, t.Name)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: v}}, nil
+	case []any:
+		if t.Kind == yang.Yempty && len(v) == 1 && v[0] == nil {
+			return &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: true}}, nil
+		}
+	}
+	return nil, fmt.Errorf("%v is not a valid %s", v, t.Name)
+}
+
+// convert returns the scalar value as the type, e.g. a uint for an int leaf.
+func convert(t *yang.YangType, tv *gpb.TypedValue) (*gpb.TypedValue, error) {
+	if t.Kind == yang.Yleafref || t.Kind == yang.Ynone {
+		return tv, nil
+	}
+	if b, ok := tv.GetValue().(*gpb.TypedValue_BytesVal); ok && t.Kind == yang.Ybinary {
+		return &gpb.TypedValue{Value: b}, nil
+	}
+	s, err := scalarString(tv)
+	if err != nil {
+		return nil, err
+	}
+	return fromString(t, s)
+}
+
+// scalarString returns the string form of the scalar value.
+func scalarString(tv *gpb.TypedValue) (string, error) {
+	switch v := tv.GetValue().(type) {
+	case *gpb.TypedValue_StringVal:
+		return v.StringVal, nil
+	case *gpb.TypedValue_AsciiVal:
+		return v.AsciiVal, nil
+	case *gpb.TypedValue_IntVal:
+		return strconv.FormatInt(v.IntVal, 10), nil
+	case *gpb.TypedValue_UintVal:
+		return strconv.FormatUint(v.UintVal, 10), nil
+	case *gpb.TypedValue_BoolVal:
+		return strconv.FormatBool(v.BoolVal), nil
+	case *gpb.TypedValue_DoubleVal:
+		return strconv.FormatFloat(v.DoubleVal, 'g', -1, 64), nil
+	case *gpb.TypedValue_BytesVal:
+		return base64.StdEncoding.EncodeToString(v.BytesVal), nil
+	}
+	return "", fmt.Errorf("unsupported scalar value %T", tv.GetValue())
+}
+
+// jsonValue returns the RFC 7951 JSON value of the leaf.
+func jsonValue(tv *gpb.TypedValue, kind yang.TypeKind) any {
+	switch v := tv.GetValue().(type) {
+	case *gpb.TypedValue_IntVal:
+		if kind == yang.Yint64 {
+			return strconv.FormatInt(v.IntVal, 10)
+		}
+		return v.IntVal
+	case *gpb.TypedValue_UintVal:
+		if kind == yang.Yuint64 {
+			return strconv.FormatUint(v.UintVal, 10)
+		}
+		return v.UintVal
+	case *gpb.TypedValue_DoubleVal:
+		if kind == yang.Ydecimal64 {
+			return strconv.FormatFloat(v.DoubleVal, 'f', -1, 64)
+		}
+		return v.DoubleVal
+	case *gpb.TypedValue_BoolVal:
+		if kind == yang.Yempty {
+			return []any{nil}
+		}
+		return v.BoolVal
+	case *gpb.TypedValue_LeaflistVal:
+		var arr []any
+		for _, e := range v.LeaflistVal.GetElement() {
+			arr = append(arr, jsonValue(e, kind))
+		}
+		return arr
+	}
+	s, _ := scalarString(tv)
+	return s
+}
+
+// jsonTree returns the RFC 7951 JSON of the node at the path from its leaves.
+// Names are not qualified by their modules.
+func jsonTree(elems []*gpb.PathElem, ls []*leaf) ([]byte, error) {
+	if len(ls) == 1 && len(ls[0].elems) == len(elems) {
+		return json.Marshal(jsonValue(ls[0].val, ls[0].kind))
+	}
+	// Build the tree from the parent of the node, so that its keys are known.
+	depth := max(len(elems)-1, 0)
+	root := make(map[string]any)
+	for _, l := range ls {
+		obj := root
+		rel := l.elems[depth:]
+		for _, e := range rel[:len(rel)-1] {
+			if len(e.GetKey()) == 0 {
+				next, ok := obj[e.GetName()].(map[string]any)
+				if !ok {
+					next = make(map[string]any)
+					obj[e.GetName()] = next
+				}
+				obj = next
+				continue
+			}
+			list, ok := obj[e.GetName()].(map[string]map[string]any)
+			if !ok {
+				list = make(map[string]map[string]any)
+				obj[e.GetName()] = list
+			}
+			id := pathString([]*gpb.PathElem{e})
+			entry, ok := list[id]
+			if !ok {
+				entry = make(map[string]any)
+				for k, v := range e.GetKey() {
+					entry[k] = v
+				}
+				list[id] = entry
+			}
+			obj = entry
+		}
+		obj[rel[len(rel)-1].GetName()] = jsonValue(l.val, l.kind)
+	}
+	var tree any = listsToArrays(root)
+	if len(elems) > 0 {
+		last := elems[len(elems)-1]
+		tree = tree.(map[string]any)[last.GetName()]
+		if arr, ok := tree.([]any); ok && len(last.GetKey()) > 0 && len(arr) == 1 {
+			tree = arr[0]
+		}
+	}
+	return json.Marshal(tree)
+}
+
+// listsToArrays replaces the lists of the tree, which are maps of their entries
+// by key, with JSON arrays sorted by key.
+func listsToArrays(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, c := range v {
+			v[k] = listsToArrays(c)
+		}
+		return v
+	case map[string]map[string]any:
+		ids := make([]string, 0, len(v))
+		for id := range v {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		arr := make([]any, 0, len(ids))
+		for _, id := range ids {
+			arr = append(arr, listsToArrays(map[string]any(v[id])))
+		}
+		return arr
+	}
+	return v
+}
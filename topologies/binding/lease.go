@@ -0,0 +1,468 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	opb "github.com/openconfig/ondatra/proto"
+	"github.com/pborman/uuid"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// leases locks the devices of a static binding for one reservation at a time,
+// so that several users can share the devices of one binding file.
+//
+// Each device is locked by a lease file named after the device in a directory
+// that all the users can access, e.g. on a shared file system.  A lease has an
+// owner and an expiry, which the holder keeps extending while the reservation
+// is held.  A lease that has expired is stale, and is taken over by the next
+// reservation of the device.  The testbed of each reservation is recorded in
+// the same directory, so that the reservation can be fetched by its ID.
+//
+// The holder of a lease keeps a token, a hard link to the lease file named
+// after the reservation, and only ever writes the lease through it.  A lease
+// that has been taken over is a new file, so it is never overwritten by the
+// previous holder, who finds that the token no longer links to it.
+type leases struct {
+	dir   string
+	owner string
+	ttl   time.Duration
+	now   func() time.Time // To be stubbed out by unit tests.
+
+	// beforeRenew is called by renew between the checks of the ownership
+	// of the lease and its write; to be stubbed out by unit tests.
+	beforeRenew func()
+
+	mu      sync.Mutex
+	id      string   // ID of the held reservation, or empty if none.
+	devices []string // Devices of the held reservation.
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// lease is the content of a device lease file.
+type lease struct {
+	ID     string    `json:"id"`
+	Owner  string    `json:"owner"`
+	Device string    `json:"device"`
+	Expiry time.Time `json:"expiry"`
+}
+
+// record is the content of a reservation file.
+type record struct {
+	ID      string   `json:"id"`
+	Owner   string   `json:"owner"`
+	Devices []string `json:"devices"`
+	Testbed string   `json:"testbed"` // Testbed in textproto format.
+}
+
+func newLeases(dir string, ttl time.Duration) *leases {
+	return &leases{dir: dir, owner: leaseOwner(), ttl: ttl, now: time.Now}
+}
+
+// leaseOwner identifies the user and process that holds a lease.
+func leaseOwner() string {
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s@%s pid %d", name, host, os.Getpid())
+}
+
+func (l *leases) leaseFile(device string) string {
+	return filepath.Join(l.dir, url.PathEscape(device)+".lease")
+}
+
+func (l *leases) tokenFile(id, device string) string {
+	return filepath.Join(l.dir, url.PathEscape(device)+"."+url.PathEscape(id)+".token")
+}
+
+func (l *leases) recordFile(id string) string {
+	return filepath.Join(l.dir, url.PathEscape(id)+".resv")
+}
+
+func (l *leases) read(device string) (*lease, error) {
+	data, err := os.ReadFile(l.leaseFile(device))
+	if err != nil {
+		return nil, err
+	}
+	ls := new(lease)
+	if err := json.Unmarshal(data, ls); err != nil {
+		return nil, fmt.Errorf("could not parse lease file %s: %w", l.leaseFile(device), err)
+	}
+	return ls, nil
+}
+
+// newLease returns a lease of the device for the reservation, expiring after
+// the lease duration.  The expiry is rounded to the second, so that the lease
+// files of a device have a constant size and can be rewritten in place.
+func (l *leases) newLease(id, device string) *lease {
+	return &lease{ID: id, Owner: l.owner, Device: device, Expiry: l.now().Add(l.ttl).UTC().Truncate(time.Second)}
+}
+
+// writeTemp writes the JSON data to a new temporary file in the lease
+// directory and returns its name.
+func (l *leases) writeTemp(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	f, err := os.CreateTemp(l.dir, ".tmp-")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// lock creates the lease file of the device for the reservation, taking over
+// the lease if it is stale.  Creating the lease file is atomic: it is linked
+// into place, which fails if the file already exists.  The temporary file it
+// is linked from then becomes the token of the reservation.
+func (l *leases) lock(id, device string) error {
+	for {
+		tmp, err := l.writeTemp(l.newLease(id, device))
+		if err != nil {
+			return err
+		}
+		err = os.Link(tmp, l.leaseFile(device))
+		if err == nil {
+			return os.Rename(tmp, l.tokenFile(id, device))
+		}
+		os.Remove(tmp)
+		if !errors.Is(err, os.ErrExist) {
+			return err
+		}
+
+		held, err := l.read(device)
+		if errors.Is(err, os.ErrNotExist) {
+			continue // Released in the meantime.
+		}
+		if err != nil {
+			return err
+		}
+		if held.ID == id {
+			return nil
+		}
+		if l.now().Before(held.Expiry) {
+			return fmt.Errorf("device %s is reserved by %s until %s (reservation %s): %w", device, held.Owner, held.Expiry.Format(time.RFC3339), held.ID, errLeaseHeld)
+		}
+		if err := l.breakStale(device, held); err != nil {
+			return err
+		}
+		// The stale reservation cannot be resumed without the device.
+		if err := os.Remove(l.recordFile(held.ID)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		glog.Warningf("Took over stale lease of device %s held by %s since %s (reservation %s)", device, held.Owner, held.Expiry.Format(time.RFC3339), held.ID)
+	}
+}
+
+// breakStale removes the stale lease of the device, along with the token of
+// its reservation.  The lease file is first renamed, which only one of several
+// users breaking the same lease can do, and is put back if it turns out to be
+// renewed after it was read.
+func (l *leases) breakStale(device string, stale *lease) error {
+	file := l.leaseFile(device)
+	broken := fmt.Sprintf("%s.stale-%s", file, uuid.New())
+	if err := os.Rename(file, broken); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil // Broken by someone else.
+		}
+		return err
+	}
+	defer os.Remove(broken)
+	data, err := os.ReadFile(broken)
+	if err != nil {
+		return err
+	}
+	got := new(lease)
+	if err := json.Unmarshal(data, got); err == nil && (got.ID != stale.ID || !got.Expiry.Equal(stale.Expiry)) {
+		// Not the stale lease; fails if the device has been locked since.
+		return os.Link(broken, file)
+	}
+	if linked(l.tokenFile(stale.ID, device), broken) {
+		os.Remove(l.tokenFile(stale.ID, device))
+	}
+	return nil
+}
+
+// linked returns whether both files exist and are links to the same file.
+func linked(file1, file2 string) bool {
+	fi1, err := os.Stat(file1)
+	if err != nil {
+		return false
+	}
+	fi2, err := os.Stat(file2)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(fi1, fi2)
+}
+
+// writeToken rewrites the lease through the token of the reservation.
+func (l *leases) writeToken(id, device string, ls *lease) error {
+	data, err := json.Marshal(ls)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.tokenFile(id, device), os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if fi, err := f.Stat(); err == nil && int(fi.Size()) > len(data) {
+		// Pad with whitespace rather than truncate, so that the lease always
+		// parses.
+		data = append(data, strings.Repeat(" ", int(fi.Size())-len(data))...)
+	}
+	if _, err := f.WriteAt(data, 0); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// renew extends the lease of the device for the reservation, or locks the
+// device again if the lease has been removed.
+//
+// The ownership of the lease is checked both before and after it is written,
+// since it may be broken in the meantime.  The write then only reaches the
+// broken lease, and another reservation may have locked the device since.
+func (l *leases) renew(id, device string) error {
+	file, token := l.leaseFile(device), l.tokenFile(id, device)
+	if linked(token, file) {
+		if l.beforeRenew != nil {
+			l.beforeRenew()
+		}
+		err := l.writeToken(id, device, l.newLease(id, device))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if linked(token, file) {
+			return nil
+		}
+	}
+	held, err := l.read(device)
+	if errors.Is(err, os.ErrNotExist) {
+		return l.lock(id, device)
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("lease of device %s was taken over by %s (reservation %s)", device, held.Owner, held.ID)
+}
+
+// unlock removes the lease of the device if it is held by the reservation,
+// along with the token of the reservation.  As in breakStale, the lease file
+// is first renamed, and is put back if it is not the lease of the reservation.
+func (l *leases) unlock(id, device string) error {
+	file, token := l.leaseFile(device), l.tokenFile(id, device)
+	defer os.Remove(token)
+	if !linked(token, file) {
+		return nil
+	}
+	released := fmt.Sprintf("%s.released-%s", file, uuid.New())
+	if err := os.Rename(file, released); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer os.Remove(released)
+	if !linked(token, released) {
+		return os.Link(released, file)
+	}
+	return nil
+}
+
+// errLeaseHeld is the error of a reservation of a device that is leased to
+// another reservation.
+var errLeaseHeld = errors.New("lease is held")
+
+// Bounds of the time between attempts of reserveWait.
+const (
+	minLeaseBackoff = 250 * time.Millisecond
+	maxLeaseBackoff = 15 * time.Second
+)
+
+// reserveWait calls reserve until it succeeds or the wait time passes, as long
+// as it fails because some of the devices are leased to other reservations.
+func (l *leases) reserveWait(ctx context.Context, tb *opb.Testbed, devices []string, wait time.Duration) (string, error) {
+	deadline := time.Now().Add(wait)
+	for backoff := minLeaseBackoff; ; backoff = min(2*backoff, maxLeaseBackoff) {
+		id, err := l.reserve(tb, devices)
+		if err == nil || !errors.Is(err, errLeaseHeld) {
+			return id, err
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return "", err
+		}
+		glog.Infof("Waiting to reserve the devices: %v", err)
+		timer := time.NewTimer(min(backoff, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve locks all the devices for a new reservation of the testbed and
+// returns the reservation ID.  If any device cannot be locked, none are.
+func (l *leases) reserve(tb *opb.Testbed, devices []string) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.id != "" {
+		return "", fmt.Errorf("reservation %s is already held", l.id)
+	}
+	if err := os.MkdirAll(l.dir, 0o755); err != nil {
+		return "", err
+	}
+	id := uuid.New()
+	for i, device := range devices {
+		if err := l.lock(id, device); err != nil {
+			for _, locked := range devices[:i] {
+				if err := l.unlock(id, locked); err != nil {
+					glog.Errorf("Could not unlock device %s: %v", locked, err)
+				}
+			}
+			return "", err
+		}
+	}
+
+	tbText, err := prototext.Marshal(tb)
+	if err != nil {
+		return "", err
+	}
+	tmp, err := l.writeTemp(&record{ID: id, Owner: l.owner, Devices: devices, Testbed: string(tbText)})
+	if err == nil {
+		err = os.Rename(tmp, l.recordFile(id))
+	}
+	if err != nil {
+		for _, device := range devices {
+			l.unlock(id, device)
+		}
+		return "", fmt.Errorf("could not record reservation %s: %w", id, err)
+	}
+
+	l.hold(id, devices)
+	return id, nil
+}
+
+// fetch resumes the reservation with the ID, renewing the leases of its devices,
+// and returns its testbed.
+func (l *leases) fetch(id string) (*opb.Testbed, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.id != "" {
+		return nil, fmt.Errorf("reservation %s is already held", l.id)
+	}
+	data, err := os.ReadFile(l.recordFile(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no reservation %s in %s", id, l.dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rec := new(record)
+	if err := json.Unmarshal(data, rec); err != nil {
+		return nil, fmt.Errorf("could not parse reservation file %s: %w", l.recordFile(id), err)
+	}
+	tb := new(opb.Testbed)
+	if err := prototext.Unmarshal([]byte(rec.Testbed), tb); err != nil {
+		return nil, fmt.Errorf("could not parse testbed of reservation %s: %w", id, err)
+	}
+	for _, device := range rec.Devices {
+		if err := l.renew(id, device); err != nil {
+			return nil, fmt.Errorf("could not resume reservation %s: %w", id, err)
+		}
+	}
+	l.hold(id, rec.Devices)
+	return tb, nil
+}
+
+// hold starts renewing the leases of the reservation in the background, three
+// times per lease duration.  It must be called with mu held.
+func (l *leases) hold(id string, devices []string) {
+	l.id, l.devices = id, devices
+	l.stop, l.stopped = make(chan struct{}), make(chan struct{})
+	go func(stop, stopped chan struct{}) {
+		defer close(stopped)
+		ticker := time.NewTicker(l.ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				for _, device := range devices {
+					if err := l.renew(id, device); err != nil {
+						glog.Errorf("Could not renew lease of reservation %s: %v", id, err)
+					}
+				}
+			}
+		}
+	}(l.stop, l.stopped)
+}
+
+// release stops renewing the leases of the held reservation and removes them,
+// along with the record of the reservation.
+func (l *leases) release() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.id == "" {
+		return nil
+	}
+	close(l.stop)
+	<-l.stopped
+
+	var errs []error
+	for _, device := range l.devices {
+		if err := l.unlock(l.id, device); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := os.Remove(l.recordFile(l.id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = append(errs, err)
+	}
+	l.id, l.devices = "", nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	opb "github.com/openconfig/ondatra/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// fakeClock is a clock shared by the leases of several users in a test.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newTestLeases(dir, owner string, clock *fakeClock) *leases {
+	return &leases{dir: dir, owner: owner, ttl: time.Hour, now: clock.now}
+}
+
+func TestLeases(t *testing.T) {
+	dir := t.TempDir()
+	clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	alice := newTestLeases(dir, "alice", clock)
+	bob := newTestLeases(dir, "bob", clock)
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+
+	id, err := alice.reserve(tb, []string{"dut1.example.com", "ate1.example.com"})
+	if err != nil {
+		t.Fatalf("reserve() by alice got error: %v", err)
+	}
+	if _, err := alice.reserve(tb, []string{"dut2.example.com"}); err == nil {
+		t.Errorf("reserve() by alice of a second reservation got no error, want error")
+	}
+
+	// A reservation that overlaps is refused, and does not keep the free device.
+	_, err = bob.reserve(tb, []string{"dut2.example.com", "dut1.example.com"})
+	if err == nil || !strings.Contains(err.Error(), "reserved by alice") {
+		t.Errorf("reserve() by bob got error %v, want error containing %q", err, "reserved by alice")
+	}
+	if _, err := os.Stat(bob.leaseFile("dut2.example.com")); !os.IsNotExist(err) {
+		t.Errorf("Lease of dut2.example.com got stat error %v, want it to not exist", err)
+	}
+
+	// Renewal keeps the reservation past the lease duration.
+	clock.t = clock.t.Add(45 * time.Minute)
+	for _, device := range alice.devices {
+		if err := alice.renew(id, device); err != nil {
+			t.Fatalf("renew() by alice got error: %v", err)
+		}
+	}
+	clock.t = clock.t.Add(45 * time.Minute)
+	if _, err := bob.reserve(tb, []string{"dut1.example.com"}); err == nil {
+		t.Fatalf("reserve() by bob of a renewed lease got no error, want error")
+	}
+
+	// Another process of alice resumes the reservation by its ID.
+	alice2 := newTestLeases(dir, "alice", clock)
+	if _, err := alice2.fetch("no-such-id"); err == nil {
+		t.Errorf("fetch() of an unknown reservation got no error, want error")
+	}
+	got, err := alice2.fetch(id)
+	if err != nil {
+		t.Fatalf("fetch() got error: %v", err)
+	}
+	if diff := cmp.Diff(tb, got, protocmp.Transform()); diff != "" {
+		t.Errorf("fetch() got testbed diff (-want,+got):\n%s", diff)
+	}
+	if err := alice2.release(); err != nil {
+		t.Errorf("release() by alice2 got error: %v", err)
+	}
+
+	// A stale lease is taken over once it expires without renewal.
+	if err := alice.release(); err != nil {
+		t.Fatalf("release() by alice got error: %v", err)
+	}
+	id, err = alice.reserve(tb, []string{"dut1.example.com"})
+	if err != nil {
+		t.Fatalf("reserve() by alice got error: %v", err)
+	}
+	clock.t = clock.t.Add(2 * time.Hour)
+	bobID, err := bob.reserve(tb, []string{"dut1.example.com"})
+	if err != nil {
+		t.Fatalf("reserve() by bob of a stale lease got error: %v", err)
+	}
+	if err := alice.renew(id, "dut1.example.com"); err == nil || !strings.Contains(err.Error(), "taken over by bob") {
+		t.Errorf("renew() by alice got error %v, want error containing %q", err, "taken over by bob")
+	}
+	if _, err := os.Stat(alice.recordFile(id)); !os.IsNotExist(err) {
+		t.Errorf("Record of stale reservation got stat error %v, want it to not exist", err)
+	}
+
+	// Release by alice leaves the lease of bob in place.
+	if err := alice.release(); err != nil {
+		t.Fatalf("release() by alice got error: %v", err)
+	}
+	if held, err := bob.read("dut1.example.com"); err != nil || held.ID != bobID {
+		t.Errorf("Lease of dut1.example.com got %v, %v, want reservation %s", held, err, bobID)
+	}
+	if err := bob.release(); err != nil {
+		t.Fatalf("release() by bob got error: %v", err)
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("Lease directory got files %v after release, want none", files)
+	}
+}
+
+func TestLeases_RenewRacesBreakStale(t *testing.T) {
+	const device = "dut1.example.com"
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+	clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	alice := newTestLeases(t.TempDir(), "alice", clock)
+	bob := newTestLeases(alice.dir, "bob", clock)
+	id, err := alice.reserve(tb, []string{device})
+	if err != nil {
+		t.Fatalf("reserve() by alice got error: %v", err)
+	}
+	stale, err := alice.read(device)
+	if err != nil {
+		t.Fatalf("read() got error: %v", err)
+	}
+
+	// Bob breaks the expired lease and locks the device after alice checked
+	// that she holds the lease, but before she rewrites it.
+	clock.t = clock.t.Add(2 * time.Hour)
+	alice.beforeRenew = func() {
+		if err := bob.breakStale(device, stale); err != nil {
+			t.Fatalf("breakStale() by bob got error: %v", err)
+		}
+		if err := bob.lock("bob-resv", device); err != nil {
+			t.Fatalf("lock() by bob got error: %v", err)
+		}
+	}
+	if err := alice.renew(id, device); err == nil || !strings.Contains(err.Error(), "taken over by bob") {
+		t.Errorf("renew() by alice got error %v, want error containing %q", err, "taken over by bob")
+	}
+	held, err := bob.read(device)
+	if err != nil {
+		t.Fatalf("read() got error: %v", err)
+	}
+	if want := clock.t.Add(time.Hour); held.ID != "bob-resv" || !held.Expiry.Equal(want) {
+		t.Errorf("Lease of %s got %+v, want reservation bob-resv until %v", device, held, want)
+	}
+}
+
+func TestLeases_RenewRacesBreakStaleConcurrently(t *testing.T) {
+	const device = "dut1.example.com"
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+	for i := 0; i < 100; i++ {
+		clock := &fakeClock{t: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+		alice := newTestLeases(t.TempDir(), "alice", clock)
+		bob := newTestLeases(alice.dir, "bob", clock)
+		id, err := alice.reserve(tb, []string{device})
+		if err != nil {
+			t.Fatalf("reserve() by alice got error: %v", err)
+		}
+		stale, err := alice.read(device)
+		if err != nil {
+			t.Fatalf("read() got error: %v", err)
+		}
+		clock.t = clock.t.Add(2 * time.Hour)
+
+		var wg sync.WaitGroup
+		var renewErr, lockErr error
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			renewErr = alice.renew(id, device)
+		}()
+		go func() {
+			defer wg.Done()
+			if lockErr = bob.breakStale(device, stale); lockErr == nil {
+				lockErr = bob.lock("bob-resv", device)
+			}
+		}()
+		wg.Wait()
+
+		held, err := alice.read(device)
+		if err != nil {
+			t.Fatalf("read() got error: %v", err)
+		}
+		switch {
+		case (renewErr == nil) == (lockErr == nil):
+			t.Fatalf("renew() by alice got error %v and lock() by bob got error %v, want exactly one to succeed", renewErr, lockErr)
+		case renewErr == nil && held.ID != id:
+			t.Fatalf("renew() by alice succeeded, but lease held by %s", held.ID)
+		case lockErr == nil && held.ID != "bob-resv":
+			t.Fatalf("lock() by bob succeeded, but lease held by %s", held.ID)
+		}
+	}
+}
+
+func TestLeases_ReserveWait(t *testing.T) {
+	dir := t.TempDir()
+	tb := &opb.Testbed{Duts: []*opb.Device{{Id: "dut"}}}
+	devices := []string{"dut1.example.com"}
+	alice, bob := newLeases(dir, time.Minute), newLeases(dir, time.Minute)
+	if _, err := alice.reserve(tb, devices); err != nil {
+		t.Fatalf("reserve() by alice got error: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := bob.reserveWait(ctx, tb, devices, 0); !errors.Is(err, errLeaseHeld) {
+		t.Errorf("reserveWait() by bob without waiting got error %v, want %v", err, errLeaseHeld)
+	}
+	time.AfterFunc(100*time.Millisecond, func() {
+		if err := alice.release(); err != nil {
+			t.Errorf("release() by alice got error: %v", err)
+		}
+	})
+	if _, err := bob.reserveWait(ctx, tb, devices, time.Minute); err != nil {
+		t.Fatalf("reserveWait() by bob got error: %v", err)
+	}
+	if err := bob.release(); err != nil {
+		t.Errorf("release() by bob got error: %v", err)
+	}
+}
@@ -18,7 +18,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
 	"plugin"
 	"time"
 
@@ -31,9 +30,7 @@ import (
 	"github.com/openconfig/ondatra/binding"
 	"github.com/openconfig/ondatra/knebind"
 	knecreds "github.com/openconfig/ondatra/knebind/creds"
-	"google.golang.org/protobuf/encoding/prototext"
 
-	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
 	opb "github.com/openconfig/ondatra/proto"
 )
 
@@ -43,6 +40,9 @@ var (
 	bindingFile  = flag.String("binding", "", "static binding configuration file")
 	kneConfig    = flag.String("kne-config", "", "YAML configuration file")
 	pushConfig   = flag.Bool("push-config", true, "push device reset config supplied to static binding")
+	leaseDir     = flag.String("binding-lease-dir", "", "shared directory in which to lease the devices of the static binding, so that several users can reserve them in turn")
+	leaseTTL     = flag.Duration("binding-lease-ttl", 5*time.Minute, "duration after which a lease that is no longer renewed can be taken by another reservation")
+	recordFile   = flag.String("binding-record", "", "file in which to record the gRPC calls made through the static binding, in the gRPC binary log format")
 	kneTopo      = flag.String("kne-topo", "", "KNE topology file")
 	kneSkipReset = flag.Bool("kne-skip-reset", false, "skip the initial config reset phase when using KNE")
 	credFlags    = knecreds.DefineFlags()
@@ -130,24 +130,32 @@ func loadBinding(path, args string) (binding.Binding, error) {
 
 // staticBinding makes a static binding from the binding configuration file.
 func staticBinding(bindingFile string) (binding.Binding, error) {
-	in, err := os.ReadFile(bindingFile)
+	b, err := ReadBinding(bindingFile)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read binding file: %w", err)
-	}
-	b := &bindpb.Binding{}
-	if err := prototext.Unmarshal(in, b); err != nil {
-		return nil, fmt.Errorf("unable to parse binding file: %w", err)
+		return nil, err
 	}
 	for _, ate := range b.Ates {
 		if ate.Otg != nil && ate.Ixnetwork != nil {
 			return nil, fmt.Errorf("otg and ixnetwork are mutually exclusive, please configure one of them in ate %s binding", ate.Name)
 		}
 	}
-	return &staticBind{
+	sb := &staticBind{
 		Binding:    nil,
 		r:          resolver{b},
 		pushConfig: *pushConfig,
-	}, nil
+	}
+	if *leaseDir != "" {
+		if *leaseTTL < time.Second {
+			return nil, fmt.Errorf("-binding-lease-ttl must be at least 1s, got %v", *leaseTTL)
+		}
+		sb.leases = newLeases(*leaseDir, *leaseTTL)
+	}
+	if *recordFile != "" {
+		if sb.recorder, err = newRecorder(*recordFile); err != nil {
+			return nil, fmt.Errorf("could not create gRPC record file: %w", err)
+		}
+	}
+	return sb, nil
 }
 
 // rundataBind wraps an Ondatra binding to report rundata.
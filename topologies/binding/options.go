@@ -15,9 +15,16 @@
 package binding
 
 import (
+	"bufio"
+	"context"
 	"flag"
 	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
 
+	"github.com/golang/glog"
 	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
 	"github.com/openconfig/ondatra/binding/introspect"
 	"google.golang.org/protobuf/proto"
@@ -35,22 +42,27 @@ var (
 
 	dutSvcParams = map[introspect.Service]*svcParams{
 		introspect.GNMI: {
+			name:   "gnmi",
 			port:   *gnmiPort,
 			optsFn: (*bindpb.Device).GetGnmi,
 		},
 		introspect.GNOI: {
+			name:   "gnoi",
 			port:   *gnoiPort,
 			optsFn: (*bindpb.Device).GetGnoi,
 		},
 		introspect.GNSI: {
+			name:   "gnsi",
 			port:   *gnsiPort,
 			optsFn: (*bindpb.Device).GetGnsi,
 		},
 		introspect.GRIBI: {
+			name:   "gribi",
 			port:   *gribiPort,
 			optsFn: (*bindpb.Device).GetGribi,
 		},
 		introspect.P4RT: {
+			name:   "p4rt",
 			port:   *p4rtPort,
 			optsFn: (*bindpb.Device).GetP4Rt,
 		},
@@ -58,10 +70,12 @@ var (
 
 	ateSvcParams = map[introspect.Service]*svcParams{
 		introspect.GNMI: {
+			name:   "gnmi",
 			port:   *ateGNMIPort,
 			optsFn: (*bindpb.Device).GetGnmi,
 		},
 		introspect.OTG: {
+			name:   "otg",
 			port:   *ateOTGPort,
 			optsFn: (*bindpb.Device).GetOtg,
 		},
@@ -69,36 +83,154 @@ var (
 )
 
 type svcParams struct {
+	name   string // Name of the service in DNS SRV records.
 	port   int
 	optsFn func(*bindpb.Device) *bindpb.Options
 }
 
-// merge creates combines one or more options into one set of options.
+// merge creates combines one or more options into one set of options.  The
+// jump hosts and credential sources of an option replace those of the options
+// before it, rather than merging with them.  A username or password of an
+// option replaces the source of the options before it, and the reverse.
 func merge(bopts ...*bindpb.Options) *bindpb.Options {
 	result := &bindpb.Options{}
 	for _, bopt := range bopts {
 		if bopt != nil {
-			proto.Merge(result, bopt)
+			n := len(result.JumpHosts)
+			proto.Merge(result, bopt) // Appends copies of the jump hosts.
+			if len(bopt.JumpHosts) > 0 {
+				result.JumpHosts = result.JumpHosts[n:]
+			}
+			switch {
+			case bopt.UsernameFrom != nil:
+				result.UsernameFrom = proto.Clone(bopt.UsernameFrom).(*bindpb.Secret)
+				result.Username = ""
+			case bopt.Username != "":
+				result.UsernameFrom = nil
+			}
+			switch {
+			case bopt.PasswordFrom != nil:
+				result.PasswordFrom = proto.Clone(bopt.PasswordFrom).(*bindpb.Secret)
+				result.Password = ""
+			case bopt.Password != "":
+				result.PasswordFrom = nil
+			}
+			if bopt.Token != nil {
+				result.Token = proto.Clone(bopt.Token).(*bindpb.Token)
+			}
 		}
 	}
 	return result
 }
 
+// To be stubbed out by unit tests.
+var lookupSRVFn = net.DefaultResolver.LookupSRV
+
 type resolver struct {
 	*bindpb.Binding
 }
 
 func (r *resolver) grpc(dev *bindpb.Device, params *svcParams) *bindpb.Options {
-	targetOpts := &bindpb.Options{Target: fmt.Sprintf("%s:%d", dev.Name, params.port)}
-	return merge(targetOpts, r.Options, dev.Options, params.optsFn(dev))
+	bopts := merge(r.Options, dev.Options, params.optsFn(dev))
+	if bopts.Target == "" {
+		port := int(bopts.Port)
+		if port == 0 {
+			port = params.port
+		}
+		bopts.Target = net.JoinHostPort(host(dev, bopts), strconv.Itoa(port))
+	}
+	return bopts
 }
 
 func (r *resolver) ssh(dev *bindpb.Device) *bindpb.Options {
-	targetOpts := &bindpb.Options{Target: dev.Name}
-	return merge(targetOpts, r.Options, dev.Options, dev.Ssh)
+	bopts := merge(r.Options, dev.Options, dev.Ssh)
+	if bopts.Target == "" {
+		bopts.Target = host(dev, bopts)
+		if bopts.Port != 0 {
+			bopts.Target = net.JoinHostPort(bopts.Target, strconv.Itoa(int(bopts.Port)))
+		}
+	}
+	return bopts
 }
 
+// ixnetwork resolves the options of the IxNetwork Web API, which takes a
+// hostname without a port.
 func (r *resolver) ixnetwork(dev *bindpb.Device) *bindpb.Options {
-	targetOpts := &bindpb.Options{Target: dev.Name}
-	return merge(targetOpts, r.Options, dev.Options, dev.Ixnetwork)
+	bopts := merge(r.Options, dev.Options, dev.Ixnetwork)
+	if bopts.Target == "" {
+		bopts.Target = host(dev, bopts)
+	}
+	return bopts
+}
+
+// host returns the host to dial the device at: its address, or else its name,
+// as mapped by the hosts file of the options, if any.  The brackets of an IPv6
+// address are removed, so the host can be joined with a port.
+func host(dev *bindpb.Device, bopts *bindpb.Options) string {
+	h := dev.GetAddress()
+	if h == "" {
+		h = dev.GetName()
+	}
+	h = strings.TrimSuffix(strings.TrimPrefix(h, "["), "]")
+	if file := bopts.GetHostsFile(); file != "" {
+		addr, err := lookupHostsFile(file, h)
+		if err != nil {
+			glog.Errorf("Could not look up %s in hosts file: %v", h, err)
+		} else if addr != "" {
+			h = addr
+		}
+	}
+	return h
+}
+
+// lookupHostsFile returns the first address of the name in a file in the
+// /etc/hosts format, or the empty string if the name is not in the file.
+func lookupHostsFile(file, name string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if net.ParseIP(strings.Split(fields[0], "%")[0]) == nil {
+			return "", fmt.Errorf("%s: invalid address %q", file, fields[0])
+		}
+		for _, alias := range fields[1:] {
+			if strings.EqualFold(alias, name) {
+				return fields[0], nil
+			}
+		}
+	}
+	return "", s.Err()
+}
+
+// lookupSRV returns the host and port of the service in the DNS SRV record
+// "_<service>._tcp.<host>" of the target host.
+func lookupSRV(ctx context.Context, service, target string) (string, error) {
+	h, _, err := net.SplitHostPort(target)
+	if err != nil {
+		h = target
+	}
+	_, srvs, err := lookupSRVFn(ctx, service, "tcp", h)
+	if err != nil {
+		return "", fmt.Errorf("could not look up SRV record of %s for %s: %w", h, service, err)
+	}
+	if len(srvs) == 0 {
+		return "", fmt.Errorf("no SRV record of %s for %s", h, service)
+	}
+	return net.JoinHostPort(strings.TrimSuffix(srvs[0].Target, "."), strconv.Itoa(int(srvs[0].Port))), nil
+}
+
+// withDefaultPort returns the target with the port added if it has none.
+func withDefaultPort(target string, port int) string {
+	if _, _, err := net.SplitHostPort(target); err == nil {
+		return target
+	}
+	return net.JoinHostPort(strings.TrimSuffix(strings.TrimPrefix(target, "["), "]"), strconv.Itoa(port))
 }
@@ -15,6 +15,11 @@
 package binding
 
 import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -84,6 +89,70 @@ func TestMerge(t *testing.T) {
 			Username:   "username2",
 			Password:   "password3",
 		},
+	}, {
+		name: "JumpHostsReplaced",
+		args: []*bindpb.Options{{
+			Proxy:     "socks5://proxy1:1080",
+			JumpHosts: []*bindpb.JumpHost{{Target: "bastion1"}, {Target: "bastion2"}},
+		}, {
+			Username: "username",
+		}, {
+			Proxy:     "http://proxy2:3128",
+			JumpHosts: []*bindpb.JumpHost{{Target: "bastion3"}},
+		}},
+		want: &bindpb.Options{
+			Username:  "username",
+			Proxy:     "http://proxy2:3128",
+			JumpHosts: []*bindpb.JumpHost{{Target: "bastion3"}},
+		},
+	}, {
+		name: "JumpHostsKept",
+		args: []*bindpb.Options{{
+			JumpHosts: []*bindpb.JumpHost{{Target: "bastion1", Username: "jump"}},
+		}, {
+			Username: "username",
+		}},
+		want: &bindpb.Options{
+			Username:  "username",
+			JumpHosts: []*bindpb.JumpHost{{Target: "bastion1", Username: "jump"}},
+		},
+	}, {
+		name: "CredentialSourcesReplaced",
+		args: []*bindpb.Options{{
+			PasswordFrom: &bindpb.Secret{Source: &bindpb.Secret_Command{Command: &bindpb.Command{Args: []string{"helper", "get"}}}},
+			Token:        &bindpb.Token{Source: &bindpb.Secret{Source: &bindpb.Secret_Env{Env: "TOKEN"}}, Lifetime: 3600},
+		}, {
+			PasswordFrom: &bindpb.Secret{Source: &bindpb.Secret_Command{Command: &bindpb.Command{Args: []string{"other-helper"}}}},
+			Token:        &bindpb.Token{Source: &bindpb.Secret{Source: &bindpb.Secret_File{File: "token.json"}}},
+		}},
+		want: &bindpb.Options{
+			PasswordFrom: &bindpb.Secret{Source: &bindpb.Secret_Command{Command: &bindpb.Command{Args: []string{"other-helper"}}}},
+			Token:        &bindpb.Token{Source: &bindpb.Secret{Source: &bindpb.Secret_File{File: "token.json"}}},
+		},
+	}, {
+		name: "CredentialSourcesOverridden",
+		args: []*bindpb.Options{{
+			UsernameFrom: &bindpb.Secret{Source: &bindpb.Secret_Env{Env: "USERNAME"}},
+			PasswordFrom: &bindpb.Secret{Source: &bindpb.Secret_Command{Command: &bindpb.Command{Args: []string{"helper", "get"}}}},
+		}, {
+			Password: "x",
+		}},
+		want: &bindpb.Options{
+			UsernameFrom: &bindpb.Secret{Source: &bindpb.Secret_Env{Env: "USERNAME"}},
+			Password:     "x",
+		},
+	}, {
+		name: "CredentialsOverridden",
+		args: []*bindpb.Options{{
+			Username: "username",
+			Password: "password",
+		}, {
+			UsernameFrom: &bindpb.Secret{Source: &bindpb.Secret_Env{Env: "USERNAME"}},
+		}},
+		want: &bindpb.Options{
+			UsernameFrom: &bindpb.Secret{Source: &bindpb.Secret_Env{Env: "USERNAME"}},
+			Password:     "password",
+		},
 	}}
 
 	for _, c := range cases {
@@ -272,3 +341,143 @@ func TestResolver_Options(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_Targets(t *testing.T) {
+	hostsFile := filepath.Join(t.TempDir(), "hosts")
+	hosts := "# Lab management addresses.\n2001:db8::2 dut2.name dut2\n192.0.2.3\tDUT3.NAME # Uppercase.\n"
+	if err := os.WriteFile(hostsFile, []byte(hosts), 0600); err != nil {
+		t.Fatal(err)
+	}
+	r := resolver{&bindpb.Binding{
+		Duts: []*bindpb.Device{{
+			Name:    "dut1.name",
+			Address: "2001:db8::1",
+			Gnmi:    &bindpb.Options{Port: 50051},
+			Ssh:     &bindpb.Options{Port: 2222},
+		}, {
+			Name:    "dut2.name",
+			Options: &bindpb.Options{HostsFile: hostsFile},
+			Gribi:   &bindpb.Options{Target: "gribi.name:9340", Port: 50052},
+		}, {
+			Name:    "dut3.name",
+			Address: "[2001:db8::3]",
+			Options: &bindpb.Options{HostsFile: hostsFile},
+		}},
+		Ates: []*bindpb.Device{{
+			Name:      "ate.name",
+			Address:   "192.0.2.10",
+			Options:   &bindpb.Options{Port: 8443},
+			Ixnetwork: &bindpb.Options{},
+		}},
+	}}
+
+	cases := []struct {
+		test string
+		got  *bindpb.Options
+		want string
+	}{{
+		test: "ipv6 address with port",
+		got:  r.grpc(r.Duts[0], dutSvcParams[introspect.GNMI]),
+		want: "[2001:db8::1]:50051",
+	}, {
+		test: "ipv6 address with default port",
+		got:  r.grpc(r.Duts[0], dutSvcParams[introspect.GNOI]),
+		want: "[2001:db8::1]:" + strconv.Itoa(*gnoiPort),
+	}, {
+		test: "ssh ipv6 address with port",
+		got:  r.ssh(r.Duts[0]),
+		want: "[2001:db8::1]:2222",
+	}, {
+		test: "hosts file",
+		got:  r.grpc(r.Duts[1], dutSvcParams[introspect.GNMI]),
+		want: "[2001:db8::2]:" + strconv.Itoa(*gnmiPort),
+	}, {
+		test: "target over port",
+		got:  r.grpc(r.Duts[1], dutSvcParams[introspect.GRIBI]),
+		want: "gribi.name:9340",
+	}, {
+		test: "ssh hosts file",
+		got:  r.ssh(r.Duts[1]),
+		want: "2001:db8::2",
+	}, {
+		test: "bracketed address not in hosts file",
+		got:  r.grpc(r.Duts[2], dutSvcParams[introspect.P4RT]),
+		want: "[2001:db8::3]:" + strconv.Itoa(*p4rtPort),
+	}, {
+		test: "device port",
+		got:  r.grpc(r.Ates[0], ateSvcParams[introspect.OTG]),
+		want: "192.0.2.10:8443",
+	}, {
+		test: "ixnetwork without port",
+		got:  r.ixnetwork(r.Ates[0]),
+		want: "192.0.2.10",
+	}}
+	for _, c := range cases {
+		t.Run(c.test, func(t *testing.T) {
+			if got := c.got.GetTarget(); got != c.want {
+				t.Errorf("Resolved target got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestLookupHostsFile(t *testing.T) {
+	hostsFile := filepath.Join(t.TempDir(), "hosts")
+	if err := os.WriteFile(hostsFile, []byte("fe80::1%eth0 dut.name\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := lookupHostsFile(hostsFile, "DUT.name"); err != nil || got != "fe80::1%eth0" {
+		t.Errorf("lookupHostsFile() got %q, %v, want %q", got, err, "fe80::1%eth0")
+	}
+	if got, err := lookupHostsFile(hostsFile, "other.name"); err != nil || got != "" {
+		t.Errorf("lookupHostsFile() of an unknown name got %q, %v, want no address", got, err)
+	}
+
+	if err := os.WriteFile(hostsFile, []byte("dut.name 192.0.2.1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lookupHostsFile(hostsFile, "dut.name"); err == nil {
+		t.Errorf("lookupHostsFile() of an invalid file got no error, want error")
+	}
+}
+
+func TestLookupSRV(t *testing.T) {
+	orig := lookupSRVFn
+	defer func() { lookupSRVFn = orig }()
+	lookupSRVFn = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		if service != "gnmi" || proto != "tcp" || name != "dut.name" {
+			return "", nil, errors.New("no such host")
+		}
+		return "", []*net.SRV{{Target: "mgmt.dut.name.", Port: 50051}, {Target: "other.name.", Port: 9339}}, nil
+	}
+
+	for _, target := range []string{"dut.name:9339", "dut.name"} {
+		got, err := lookupSRV(context.Background(), "gnmi", target)
+		if err != nil {
+			t.Fatalf("lookupSRV(%q) got error: %v", target, err)
+		}
+		if want := "mgmt.dut.name:50051"; got != want {
+			t.Errorf("lookupSRV(%q) got %q, want %q", target, got, want)
+		}
+	}
+	if _, err := lookupSRV(context.Background(), "ssh", "dut.name"); err == nil {
+		t.Errorf("lookupSRV() of a missing record got no error, want error")
+	}
+}
+
+func TestWithDefaultPort(t *testing.T) {
+	cases := []struct {
+		target, want string
+	}{
+		{"dut.name", "dut.name:22"},
+		{"dut.name:2222", "dut.name:2222"},
+		{"2001:db8::1", "[2001:db8::1]:22"},
+		{"[2001:db8::1]", "[2001:db8::1]:22"},
+		{"[2001:db8::1]:2222", "[2001:db8::1]:2222"},
+	}
+	for _, c := range cases {
+		if got := withDefaultPort(c.target, 22); got != c.want {
+			t.Errorf("withDefaultPort(%q) got %q, want %q", c.target, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
+)
+
+// dialFunc dials an address on a network, like net.Dialer.DialContext.
+type dialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// Dial implements proxy.Dialer, to dial a SOCKS5 proxy through a jump host.
+func (d dialFunc) Dial(network, addr string) (net.Conn, error) {
+	return d(context.Background(), network, addr)
+}
+
+// DialContext implements proxy.ContextDialer.
+func (d dialFunc) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	return d(ctx, network, addr)
+}
+
+// tunneled returns whether the device is dialed through a proxy or jump host.
+func tunneled(bopts *bindpb.Options) bool {
+	return bopts.GetProxy() != "" || len(bopts.GetJumpHosts()) > 0
+}
+
+// deviceDialer returns the function to dial the device with: through the proxy
+// of the options, if any, to the first jump host, then through each jump host
+// in turn to the next, and finally to the device.
+func deviceDialer(bopts *bindpb.Options) (dialFunc, error) {
+	dial := dialFunc((&net.Dialer{}).DialContext)
+	if p := bopts.GetProxy(); p != "" {
+		var err error
+		if dial, err = proxyDialer(p, dial); err != nil {
+			return nil, err
+		}
+	}
+	for _, jh := range bopts.GetJumpHosts() {
+		config, err := jumpConfig(jh)
+		if err != nil {
+			return nil, fmt.Errorf("jump host %s: %w", jh.GetTarget(), err)
+		}
+		dial = jumpDialer(withDefaultPort(jh.GetTarget(), 22), config, dial)
+	}
+	return dial, nil
+}
+
+// parseProxy parses the URL of a SOCKS5 or HTTP CONNECT proxy.
+func parseProxy(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy: %w", err)
+	}
+	switch u.Scheme {
+	case "socks5", "socks5h", "http":
+	default:
+		return nil, fmt.Errorf("proxy %s has unsupported scheme %q, want socks5 or http", u.Redacted(), u.Scheme)
+	}
+	if u.Port() == "" {
+		return nil, fmt.Errorf("proxy %s has no port", u.Redacted())
+	}
+	return u, nil
+}
+
+// proxyDialer returns a function that dials through the proxy, which it dials
+// with the forward function.
+func proxyDialer(rawURL string, forward dialFunc) (dialFunc, error) {
+	u, err := parseProxy(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "http" {
+		return func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return httpConnect(ctx, u, forward, addr)
+		}, nil
+	}
+	var auth *proxy.Auth
+	if u.User != nil {
+		password, _ := u.User.Password()
+		auth = &proxy.Auth{User: u.User.Username(), Password: password}
+	}
+	d, err := proxy.SOCKS5("tcp", u.Host, auth, forward)
+	if err != nil {
+		return nil, err
+	}
+	return d.(proxy.ContextDialer).DialContext, nil
+}
+
+// httpConnect dials the address through the HTTP proxy with a CONNECT request.
+func httpConnect(ctx context.Context, u *url.URL, forward dialFunc, addr string) (_ net.Conn, rerr error) {
+	conn, err := forward(ctx, "tcp", u.Host)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial proxy %s: %w", u.Redacted(), err)
+	}
+	defer func() {
+		if rerr != nil {
+			conn.Close()
+		}
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		cred := base64.StdEncoding.EncodeToString([]byte(u.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+cred)
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CONNECT response of proxy %s: %w", u.Redacted(), err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", u.Redacted(), addr, resp.Status)
+	}
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a connection whose first bytes were read into a buffer.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// jumpConfig returns the config of the SSH client of the jump host.
+func jumpConfig(jh *bindpb.JumpHost) (*ssh.ClientConfig, error) {
+	config := &ssh.ClientConfig{User: jh.GetUsername()}
+	if file := jh.GetKeyFile(); file != "" {
+		key, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse key_file %s: %w", file, err)
+		}
+		config.Auth = append(config.Auth, ssh.PublicKeys(signer))
+	}
+	password := jh.GetPassword()
+	if s := jh.GetPasswordFrom(); s != nil {
+		var err error
+		if password, err = readCachedSecret(s); err != nil {
+			return nil, fmt.Errorf("could not read password: %w", err)
+		}
+	}
+	if password != "" {
+		config.Auth = append(config.Auth, ssh.Password(password), ssh.KeyboardInteractive(sshInteractive(password)))
+	}
+	if jh.GetSkipVerify() {
+		config.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+	} else {
+		cb, err := knownHostsCallback()
+		if err != nil {
+			return nil, err
+		}
+		config.HostKeyCallback = cb
+	}
+	return config, nil
+}
+
+// jumpDialer returns a function that dials through the SSH jump host, which it
+// dials with the forward function.  Each connection has its own SSH connection
+// to the jump host, which is closed with it.
+func jumpDialer(target string, config *ssh.ClientConfig, forward dialFunc) dialFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		sc, err := dialSSH(ctx, forward, target, config)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to jump host %s: %w", target, err)
+		}
+		conn, err := sc.DialContext(ctx, network, addr)
+		if err != nil {
+			sc.Close()
+			return nil, fmt.Errorf("could not dial %s through jump host %s: %w", addr, target, err)
+		}
+		return &jumpConn{Conn: conn, sc: sc}, nil
+	}
+}
+
+// jumpConn is a connection through a jump host.
+type jumpConn struct {
+	net.Conn
+	sc *ssh.Client
+}
+
+func (c *jumpConn) Close() error {
+	err := c.Conn.Close()
+	c.sc.Close()
+	return err
+}
+
+// dialSSH connects an SSH client to the address, which it dials with the dial
+// function.
+func dialSSH(ctx context.Context, dial dialFunc, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	conn, err := dial(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
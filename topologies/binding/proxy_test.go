@@ -0,0 +1,328 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	"github.com/openconfig/ondatra/binding/introspect"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+)
+
+// listen starts a TCP server on the loopback address that handles each
+// connection with the function, until the test ends.
+func listen(t *testing.T, handle func(net.Conn)) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go handle(conn)
+		}
+	}()
+	return lis.Addr().String()
+}
+
+// pipe copies between the two connections until either is closed.
+func pipe(a, b io.ReadWriteCloser) {
+	done := make(chan struct{}, 2)
+	go func() { io.Copy(a, b); done <- struct{}{} }()
+	go func() { io.Copy(b, a); done <- struct{}{} }()
+	<-done
+	a.Close()
+	b.Close()
+}
+
+func startEchoServer(t *testing.T) string {
+	return listen(t, func(conn net.Conn) {
+		defer conn.Close()
+		io.Copy(conn, conn)
+	})
+}
+
+// startHTTPProxy starts an HTTP CONNECT proxy that requires the credentials.
+func startHTTPProxy(t *testing.T, user, password string) string {
+	wantAuth := "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+	return listen(t, func(conn net.Conn) {
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			conn.Close()
+			return
+		}
+		if req.Method != http.MethodConnect || req.Header.Get("Proxy-Authorization") != wantAuth {
+			fmt.Fprint(conn, "HTTP/1.1 407 Proxy Authentication Required\r\n\r\n")
+			conn.Close()
+			return
+		}
+		target, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			fmt.Fprint(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+			conn.Close()
+			return
+		}
+		fmt.Fprint(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		pipe(conn, target)
+	})
+}
+
+// startSOCKS5Proxy starts a SOCKS5 proxy that requires the credentials.
+func startSOCKS5Proxy(t *testing.T, user, password string) string {
+	return listen(t, func(conn net.Conn) {
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		readN := func(n int) []byte {
+			b := make([]byte, n)
+			if _, err := io.ReadFull(r, b); err != nil {
+				return nil
+			}
+			return b
+		}
+		// Greeting, with username and password authentication.
+		hdr := readN(2)
+		if hdr == nil || hdr[0] != 5 || readN(int(hdr[1])) == nil {
+			return
+		}
+		conn.Write([]byte{5, 2})
+		ver := readN(2)
+		if ver == nil {
+			return
+		}
+		gotUser := readN(int(ver[1]))
+		plen := readN(1)
+		if plen == nil {
+			return
+		}
+		gotPassword := readN(int(plen[0]))
+		if string(gotUser) != user || string(gotPassword) != password {
+			conn.Write([]byte{1, 1})
+			return
+		}
+		conn.Write([]byte{1, 0})
+
+		// CONNECT request.
+		req := readN(4)
+		if req == nil || req[1] != 1 {
+			return
+		}
+		var host string
+		switch req[3] {
+		case 1:
+			host = net.IP(readN(4)).String()
+		case 3:
+			n := readN(1)
+			if n == nil {
+				return
+			}
+			host = string(readN(int(n[0])))
+		case 4:
+			host = net.IP(readN(16)).String()
+		}
+		port := readN(2)
+		if port == nil {
+			return
+		}
+		target, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))))
+		if err != nil {
+			conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+			return
+		}
+		conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+		pipe(&bufferedConn{Conn: conn, r: r}, target)
+	})
+}
+
+// startJumpHost starts an SSH server that forwards TCP connections for the user
+// with the password.
+func startJumpHost(t *testing.T, user, password string) string {
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, p []byte) (*ssh.Permissions, error) {
+			if conn.User() == user && string(p) == password {
+				return nil, nil
+			}
+			return nil, errors.New("login error")
+		},
+	}
+	config.AddHostKey(serverSigner)
+	return listen(t, func(conn net.Conn) {
+		sc, chans, reqs, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		defer sc.Close()
+		go ssh.DiscardRequests(reqs)
+		for newChannel := range chans {
+			if newChannel.ChannelType() != "direct-tcpip" {
+				newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+				continue
+			}
+			var msg struct {
+				Host     string
+				Port     uint32
+				OrigHost string
+				OrigPort uint32
+			}
+			if err := ssh.Unmarshal(newChannel.ExtraData(), &msg); err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			target, err := net.Dial("tcp", net.JoinHostPort(msg.Host, strconv.Itoa(int(msg.Port))))
+			if err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			channel, chReqs, err := newChannel.Accept()
+			if err != nil {
+				target.Close()
+				continue
+			}
+			go ssh.DiscardRequests(chReqs)
+			go pipe(channel, target)
+		}
+	})
+}
+
+func TestDeviceDialer(t *testing.T) {
+	echo := startEchoServer(t)
+	httpProxy := startHTTPProxy(t, "alice", "secret")
+	socksProxy := startSOCKS5Proxy(t, "alice", "secret")
+	jump1 := startJumpHost(t, "bob", "jump1")
+	jump2 := startJumpHost(t, "carol", "jump2")
+
+	tests := []struct {
+		desc    string
+		opts    *bindpb.Options
+		wantErr string
+	}{{
+		desc: "direct",
+		opts: &bindpb.Options{},
+	}, {
+		desc: "http proxy",
+		opts: &bindpb.Options{Proxy: "http://alice:secret@" + httpProxy},
+	}, {
+		desc:    "http proxy wrong password",
+		opts:    &bindpb.Options{Proxy: "http://alice:wrong@" + httpProxy},
+		wantErr: "407",
+	}, {
+		desc: "socks5 proxy",
+		opts: &bindpb.Options{Proxy: "socks5://alice:secret@" + socksProxy},
+	}, {
+		desc:    "socks5 proxy wrong password",
+		opts:    &bindpb.Options{Proxy: "socks5://alice:wrong@" + socksProxy},
+		wantErr: "authentication failed",
+	}, {
+		desc: "jump host",
+		opts: &bindpb.Options{JumpHosts: []*bindpb.JumpHost{
+			{Target: jump1, Username: "bob", Password: "jump1", SkipVerify: true},
+		}},
+	}, {
+		desc: "jump host chain",
+		opts: &bindpb.Options{JumpHosts: []*bindpb.JumpHost{
+			{Target: jump1, Username: "bob", Password: "jump1", SkipVerify: true},
+			{Target: jump2, Username: "carol", Password: "jump2", SkipVerify: true},
+		}},
+	}, {
+		desc: "proxy to jump host",
+		opts: &bindpb.Options{
+			Proxy: "socks5://alice:secret@" + socksProxy,
+			JumpHosts: []*bindpb.JumpHost{
+				{Target: jump1, Username: "bob", Password: "jump1", SkipVerify: true},
+			},
+		},
+	}, {
+		desc: "jump host wrong password",
+		opts: &bindpb.Options{JumpHosts: []*bindpb.JumpHost{
+			{Target: jump1, Username: "bob", Password: "wrong", SkipVerify: true},
+		}},
+		wantErr: "could not connect to jump host",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			dial, err := deviceDialer(tt.opts)
+			if err != nil {
+				t.Fatalf("deviceDialer() got error: %v", err)
+			}
+			conn, err := dial(ctx, "tcp", echo)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Dial() got error %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Dial() got error: %v", err)
+			}
+			defer conn.Close()
+			const msg = "hello through the tunnel\n"
+			if _, err := io.WriteString(conn, msg); err != nil {
+				t.Fatalf("Write() got error: %v", err)
+			}
+			got, err := bufio.NewReader(conn).ReadString('\n')
+			if err != nil {
+				t.Fatalf("Read() got error: %v", err)
+			}
+			if got != msg {
+				t.Errorf("Read() got %q, want %q", got, msg)
+			}
+		})
+	}
+}
+
+func TestDialer_Tunneled(t *testing.T) {
+	var gotTarget string
+	origDialFn := grpcDialContextFn
+	defer func() { grpcDialContextFn = origDialFn }()
+	grpcDialContextFn = func(target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+		gotTarget = target
+		return nil, nil
+	}
+
+	bopts := &bindpb.Options{
+		Target:    "dut.lab:9339",
+		JumpHosts: []*bindpb.JumpHost{{Target: "bastion", SkipVerify: true}},
+	}
+	dialer, err := makeDialer(dutSvcParams[introspect.GNMI], bopts, nil)
+	if err != nil {
+		t.Fatalf("makeDialer() got error: %v", err)
+	}
+	if _, err := dialer.DialFunc(context.Background(), dialer.DialTarget); err != nil {
+		t.Fatalf("DialFunc() got error: %v", err)
+	}
+	if want := "passthrough:///dut.lab:9339"; gotTarget != want {
+		t.Errorf("DialFunc() dialed target %q, want %q", gotTarget, want)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+	binlogpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// recorder records the gRPC calls made through the dialers of a static
+// binding in the gRPC binary log format, which the replayer parses: each call
+// event is a GrpcLogEntry, written as a 4-byte big-endian length followed by
+// the entry.  The calls are recorded from the client side, so the credentials
+// that the transport adds to each call are not recorded.
+type recorder struct {
+	mu     sync.Mutex
+	f      *os.File // Nil once the recorder is closed.
+	callID atomic.Uint64
+}
+
+// newRecorder creates a recorder that writes to the file, truncating it.
+func newRecorder(file string) (*recorder, error) {
+	f, err := os.Create(file)
+	if err != nil {
+		return nil, err
+	}
+	return &recorder{f: f}, nil
+}
+
+// close syncs and closes the file of the recorder.  The calls made after the
+// recorder is closed are not recorded.
+func (r *recorder) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return nil
+	}
+	f := r.f
+	r.f = nil
+	return errors.Join(f.Sync(), f.Close())
+}
+
+// dialOpts returns the dial options that install the recording interceptors.
+func (r *recorder) dialOpts() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.unary),
+		grpc.WithChainStreamInterceptor(r.stream),
+	}
+}
+
+// write writes the entry to the log.  A call is not failed if it cannot be
+// recorded, so errors are only logged.
+func (r *recorder) write(entry *binlogpb.GrpcLogEntry) {
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		glog.Errorf("Could not marshal gRPC log entry: %v", err)
+		return
+	}
+	buf := make([]byte, 4, 4+len(data))
+	binary.BigEndian.PutUint32(buf, uint32(len(data)))
+	buf = append(buf, data...)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return
+	}
+	if _, err := r.f.Write(buf); err != nil {
+		glog.Errorf("Could not write gRPC log entry: %v", err)
+	}
+}
+
+// call records the events of one gRPC call.
+type call struct {
+	r   *recorder
+	id  uint64
+	seq atomic.Uint64
+}
+
+func (r *recorder) newCall() *call {
+	return &call{r: r, id: r.callID.Add(1)}
+}
+
+func (c *call) log(entry *binlogpb.GrpcLogEntry) {
+	entry.Timestamp = timestamppb.New(nowFn())
+	entry.CallId = c.id
+	entry.SequenceIdWithinCall = c.seq.Add(1)
+	entry.Logger = binlogpb.GrpcLogEntry_LOGGER_CLIENT
+	c.r.write(entry)
+}
+
+func (c *call) clientHeader(ctx context.Context, cc *grpc.ClientConn, method string) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	hdr := &binlogpb.ClientHeader{
+		Metadata:   metadataProto(md),
+		MethodName: method,
+		Authority:  cc.Target(),
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		hdr.Timeout = durationpb.New(time.Until(deadline))
+	}
+	c.log(&binlogpb.GrpcLogEntry{
+		Type:    binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HEADER,
+		Payload: &binlogpb.GrpcLogEntry_ClientHeader{ClientHeader: hdr},
+	})
+}
+
+func (c *call) serverHeader(md metadata.MD, p *peer.Peer) {
+	c.log(&binlogpb.GrpcLogEntry{
+		Type:    binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_HEADER,
+		Payload: &binlogpb.GrpcLogEntry_ServerHeader{ServerHeader: &binlogpb.ServerHeader{Metadata: metadataProto(md)}},
+		Peer:    addressProto(p),
+	})
+}
+
+func (c *call) message(typ binlogpb.GrpcLogEntry_EventType, msg any) {
+	entry := &binlogpb.GrpcLogEntry{Type: typ}
+	if m, ok := msg.(proto.Message); ok {
+		if data, err := proto.Marshal(m); err == nil {
+			entry.Payload = &binlogpb.GrpcLogEntry_Message{Message: &binlogpb.Message{Length: uint32(len(data)), Data: data}}
+		} else {
+			entry.PayloadTruncated = true
+		}
+	} else {
+		entry.PayloadTruncated = true
+	}
+	c.log(entry)
+}
+
+func (c *call) halfClose() {
+	c.log(&binlogpb.GrpcLogEntry{Type: binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_HALF_CLOSE})
+}
+
+func (c *call) trailer(md metadata.MD, err error) {
+	st := status.Convert(err)
+	var details []byte
+	if p := st.Proto(); len(p.GetDetails()) > 0 {
+		details, _ = proto.Marshal(p)
+	}
+	c.log(&binlogpb.GrpcLogEntry{
+		Type: binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_TRAILER,
+		Payload: &binlogpb.GrpcLogEntry_Trailer{Trailer: &binlogpb.Trailer{
+			Metadata:      metadataProto(md),
+			StatusCode:    uint32(st.Code()),
+			StatusMessage: st.Message(),
+			StatusDetails: details,
+		}},
+	})
+}
+
+// unary records a unary call.
+func (r *recorder) unary(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	c := r.newCall()
+	c.clientHeader(ctx, cc, method)
+	c.message(binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, req)
+	c.halfClose()
+
+	var header, trailer metadata.MD
+	p := new(peer.Peer)
+	opts = append(opts, grpc.Header(&header), grpc.Trailer(&trailer), grpc.Peer(p))
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if header != nil || err == nil {
+		c.serverHeader(header, p)
+	}
+	if err == nil {
+		c.message(binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, reply)
+	}
+	c.trailer(trailer, err)
+	return err
+}
+
+// stream records a streaming call.
+func (r *recorder) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	c := r.newCall()
+	c.clientHeader(ctx, cc, method)
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		c.trailer(nil, err)
+		return nil, err
+	}
+	return &recordedStream{ClientStream: cs, call: c}, nil
+}
+
+// recordedStream records the messages of a streaming call.  The server header
+// is recorded when the first message is received, and the trailer when the
+// stream ends.
+type recordedStream struct {
+	grpc.ClientStream
+	call *call
+
+	mu     sync.Mutex
+	header bool // Whether the server header was recorded.
+	done   bool // Whether the trailer was recorded.
+}
+
+func (s *recordedStream) SendMsg(m any) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.call.message(binlogpb.GrpcLogEntry_EVENT_TYPE_CLIENT_MESSAGE, m)
+	}
+	return err
+}
+
+func (s *recordedStream) CloseSend() error {
+	err := s.ClientStream.CloseSend()
+	s.call.halfClose()
+	return err
+}
+
+func (s *recordedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return err
+	}
+	if !s.header {
+		s.header = true
+		if md, hdrErr := s.ClientStream.Header(); hdrErr == nil && (md != nil || err == nil) {
+			p, _ := peer.FromContext(s.ClientStream.Context())
+			s.call.serverHeader(md, p)
+		}
+	}
+	if err == nil {
+		s.call.message(binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_MESSAGE, m)
+		return nil
+	}
+	s.done = true
+	stErr := err
+	if errors.Is(err, io.EOF) {
+		stErr = nil
+	}
+	s.call.trailer(s.ClientStream.Trailer(), stErr)
+	return err
+}
+
+// metadataProto converts the metadata to its log proto, without the reserved
+// "grpc-" keys, as the gRPC binary log does.
+func metadataProto(md metadata.MD) *binlogpb.Metadata {
+	m := new(binlogpb.Metadata)
+	for key, values := range md {
+		if strings.HasPrefix(key, "grpc-") {
+			continue
+		}
+		for _, value := range values {
+			m.Entry = append(m.Entry, &binlogpb.MetadataEntry{Key: key, Value: []byte(value)})
+		}
+	}
+	return m
+}
+
+// addressProto converts the address of the peer to its log proto, or returns
+// nil if the peer is unknown.
+func addressProto(p *peer.Peer) *binlogpb.Address {
+	if p == nil || p.Addr == nil {
+		return nil
+	}
+	switch addr := p.Addr.(type) {
+	case *net.TCPAddr:
+		if ip4 := addr.IP.To4(); ip4 != nil {
+			return &binlogpb.Address{Type: binlogpb.Address_TYPE_IPV4, Address: ip4.String(), IpPort: uint32(addr.Port)}
+		}
+		return &binlogpb.Address{Type: binlogpb.Address_TYPE_IPV6, Address: addr.IP.String(), IpPort: uint32(addr.Port)}
+	case *net.UnixAddr:
+		return &binlogpb.Address{Type: binlogpb.Address_TYPE_UNIX, Address: addr.String()}
+	default:
+		return &binlogpb.Address{Type: binlogpb.Address_TYPE_UNKNOWN, Address: addr.String()}
+	}
+}
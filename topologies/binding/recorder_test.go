@@ -0,0 +1,188 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	binlogpb "google.golang.org/grpc/binarylog/grpc_binarylog_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// readBinaryLog parses the entries of a gRPC binary log file.
+func readBinaryLog(t *testing.T, file string) []*binlogpb.GrpcLogEntry {
+	t.Helper()
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []*binlogpb.GrpcLogEntry
+	for len(data) > 0 {
+		if len(data) < 4 {
+			t.Fatalf("Log has %d trailing bytes, want a 4-byte length", len(data))
+		}
+		n := binary.BigEndian.Uint32(data)
+		data = data[4:]
+		if int(n) > len(data) {
+			t.Fatalf("Log entry has length %d, only %d bytes left", n, len(data))
+		}
+		entry := new(binlogpb.GrpcLogEntry)
+		if err := proto.Unmarshal(data[:n], entry); err != nil {
+			t.Fatalf("Could not parse log entry: %v", err)
+		}
+		entries = append(entries, entry)
+		data = data[n:]
+	}
+	return entries
+}
+
+// summary describes a log entry by its call, type and payload.
+func summary(t *testing.T, entry *binlogpb.GrpcLogEntry) string {
+	t.Helper()
+	s := fmt.Sprintf("%d/%d %v", entry.GetCallId(), entry.GetSequenceIdWithinCall(), entry.GetType())
+	switch p := entry.GetPayload().(type) {
+	case *binlogpb.GrpcLogEntry_ClientHeader:
+		s += " " + p.ClientHeader.GetMethodName()
+		for _, e := range p.ClientHeader.GetMetadata().GetEntry() {
+			s += fmt.Sprintf(" %s=%s", e.GetKey(), e.GetValue())
+		}
+	case *binlogpb.GrpcLogEntry_Message:
+		s += " " + string(p.Message.GetData())
+		if int(p.Message.GetLength()) != len(p.Message.GetData()) {
+			t.Errorf("Message of %s has length %d, want %d", s, p.Message.GetLength(), len(p.Message.GetData()))
+		}
+	case *binlogpb.GrpcLogEntry_Trailer:
+		s += " " + codes.Code(p.Trailer.GetStatusCode()).String()
+	}
+	if entry.GetTimestamp() == nil {
+		t.Errorf("Entry %s has no timestamp", s)
+	}
+	return s
+}
+
+func TestRecorder(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("dut", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, hs)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	file := filepath.Join(t.TempDir(), "record.pb")
+	rec, err := newRecorder(file)
+	if err != nil {
+		t.Fatalf("newRecorder() got error: %v", err)
+	}
+	opts := append(rec.dialOpts(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(lis.Addr().String(), opts...)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() got error: %v", err)
+	}
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "test", "recorder")
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "dut"}); err != nil {
+		t.Fatalf("Check() got error: %v", err)
+	}
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "ate"}); err == nil {
+		t.Fatalf("Check() of an unknown service got no error, want error")
+	}
+	watchCtx, cancel := context.WithCancel(context.Background())
+	stream, err := client.Watch(watchCtx, &healthpb.HealthCheckRequest{Service: "dut"})
+	if err != nil {
+		t.Fatalf("Watch() got error: %v", err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatalf("Watch() Recv() got error: %v", err)
+	}
+	cancel()
+	if _, err := stream.Recv(); err == nil {
+		t.Fatalf("Watch() Recv() after cancel got no error, want error")
+	}
+
+	if err := rec.close(); err != nil {
+		t.Fatalf("close() got error: %v", err)
+	}
+	if _, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "dut"}); err != nil {
+		t.Fatalf("Check() after close got error: %v", err)
+	}
+
+	entries := readBinaryLog(t, file)
+	var got []string
+	for _, entry := range entries {
+		got = append(got, summary(t, entry))
+	}
+	marshal := func(m proto.Message) string {
+		data, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	serving := marshal(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING})
+	want := []string{
+		"1/1 EVENT_TYPE_CLIENT_HEADER /grpc.health.v1.Health/Check test=recorder",
+		"1/2 EVENT_TYPE_CLIENT_MESSAGE " + marshal(&healthpb.HealthCheckRequest{Service: "dut"}),
+		"1/3 EVENT_TYPE_CLIENT_HALF_CLOSE",
+		"1/4 EVENT_TYPE_SERVER_HEADER",
+		"1/5 EVENT_TYPE_SERVER_MESSAGE " + serving,
+		"1/6 EVENT_TYPE_SERVER_TRAILER OK",
+		"2/1 EVENT_TYPE_CLIENT_HEADER /grpc.health.v1.Health/Check",
+		"2/2 EVENT_TYPE_CLIENT_MESSAGE " + marshal(&healthpb.HealthCheckRequest{Service: "ate"}),
+		"2/3 EVENT_TYPE_CLIENT_HALF_CLOSE",
+		"2/4 EVENT_TYPE_SERVER_TRAILER NotFound",
+		"3/1 EVENT_TYPE_CLIENT_HEADER /grpc.health.v1.Health/Watch",
+		"3/2 EVENT_TYPE_CLIENT_MESSAGE " + marshal(&healthpb.HealthCheckRequest{Service: "dut"}),
+		"3/3 EVENT_TYPE_CLIENT_HALF_CLOSE",
+		"3/4 EVENT_TYPE_SERVER_HEADER",
+		"3/5 EVENT_TYPE_SERVER_MESSAGE " + serving,
+		"3/6 EVENT_TYPE_SERVER_TRAILER Canceled",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Recorded calls got diff (-want,+got):\n%s", diff)
+	}
+
+	wantPeer := &binlogpb.Address{Type: binlogpb.Address_TYPE_IPV4, Address: "127.0.0.1", IpPort: uint32(lis.Addr().(*net.TCPAddr).Port)}
+	if lis.Addr().(*net.TCPAddr).IP.To4() == nil {
+		wantPeer = &binlogpb.Address{Type: binlogpb.Address_TYPE_IPV6, Address: lis.Addr().(*net.TCPAddr).IP.String(), IpPort: wantPeer.GetIpPort()}
+	}
+	for _, entry := range entries {
+		if entry.GetType() != binlogpb.GrpcLogEntry_EVENT_TYPE_SERVER_HEADER {
+			continue
+		}
+		if diff := cmp.Diff(wantPeer, entry.GetPeer(), protocmp.Transform()); diff != "" {
+			t.Errorf("Server header of call %d got peer diff (-want,+got):\n%s", entry.GetCallId(), diff)
+		}
+	}
+}
@@ -16,14 +16,112 @@ package binding
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/golang/glog"
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/gnmi/value"
+	fpb "github.com/openconfig/gnoi/file"
+	syspb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
+	authzpb "github.com/openconfig/gnsi/authz"
+	certzpb "github.com/openconfig/gnsi/certz"
 	spb "github.com/openconfig/gribi/v1/proto/service"
+	"github.com/openconfig/ygot/ygot"
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
 	"google.golang.org/protobuf/encoding/prototext"
 )
 
+var resetActionTimeout = flag.Duration("reset-action-timeout", 5*time.Minute, "default time for each action of the static binding device reset to complete")
+
+var (
+	// To be stubbed out by unit tests.
+	resetPollInterval = 10 * time.Second
+	nowFn             = time.Now
+)
+
+// resetStep is one step of the reset of a DUT, which fails if it does not
+// complete within its timeout.
+type resetStep struct {
+	name    string
+	timeout time.Duration
+	fn      func(context.Context, *staticDUT) error
+}
+
+// resetSteps returns the steps to reset the DUT with, in order: the CLI
+// config, the gNMI SetRequests, the gRIBI flush, and then the reset actions.
+// The steps for the first three are no-ops if they are not requested.
+func resetSteps(cfg *bindpb.Configs) []resetStep {
+	steps := []resetStep{
+		{name: "cli", timeout: *resetActionTimeout, fn: resetCLI},
+		{name: "gnmi_set_file", timeout: *resetActionTimeout, fn: resetGNMI},
+		{name: "gribi_flush", timeout: *resetActionTimeout, fn: resetGRIBI},
+	}
+	for _, action := range cfg.GetActions() {
+		step := resetStep{timeout: *resetActionTimeout}
+		if action.GetTimeout() > 0 {
+			step.timeout = time.Duration(action.GetTimeout()) * time.Second
+		}
+		switch a := action.GetAction().(type) {
+		case *bindpb.ResetAction_GnmiReplaceFile:
+			step.name = "gnmi_replace_file " + a.GnmiReplaceFile
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return gnmiReplace(ctx, dut, a.GnmiReplaceFile) }
+		case *bindpb.ResetAction_GnoiFilePut:
+			step.name = "gnoi_file_put " + a.GnoiFilePut.GetRemoteFile()
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return gnoiFilePut(ctx, dut, a.GnoiFilePut) }
+		case *bindpb.ResetAction_GnoiReboot:
+			step.name = "gnoi_reboot"
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return gnoiReboot(ctx, dut, a.GnoiReboot) }
+		case *bindpb.ResetAction_P4RtClear:
+			step.name = "p4rt_clear"
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return p4rtClear(ctx, dut, a.P4RtClear) }
+		case *bindpb.ResetAction_GnsiAuthzFile:
+			step.name = "gnsi_authz_file " + a.GnsiAuthzFile
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return gnsiAuthz(ctx, dut, a.GnsiAuthzFile) }
+		case *bindpb.ResetAction_GnsiCertz:
+			step.name = "gnsi_certz " + a.GnsiCertz.GetSslProfileId()
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return gnsiCertz(ctx, dut, a.GnsiCertz) }
+		case *bindpb.ResetAction_Verify:
+			step.name = "verify"
+			step.fn = func(ctx context.Context, dut *staticDUT) error { return verify(ctx, dut, a.Verify) }
+		default:
+			step.name = "unknown"
+			step.fn = func(context.Context, *staticDUT) error { return fmt.Errorf("no action in %v", action) }
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// runResetSteps runs the steps in order, each bounded by its timeout, and
+// stops at the first step that fails.
+func runResetSteps(ctx context.Context, dut *staticDUT, steps []resetStep) error {
+	for i, step := range steps {
+		stepCtx, cancel := context.WithTimeout(ctx, step.timeout)
+		err := step.fn(stepCtx, dut)
+		timedOut := errors.Is(stepCtx.Err(), context.DeadlineExceeded)
+		cancel()
+		if err == nil {
+			continue
+		}
+		if timedOut && ctx.Err() == nil {
+			return fmt.Errorf("reset step %d (%s) timed out after %v: %w", i+1, step.name, step.timeout, err)
+		}
+		return fmt.Errorf("reset step %d (%s) failed: %w", i+1, step.name, err)
+	}
+	return nil
+}
+
 func readCLI(path string) (string, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -50,12 +148,16 @@ func resetCLI(ctx context.Context, dut *staticDUT) error {
 		return nil
 	}
 
-	cli, err := dut.DialCLI(ctx)
+	cli, err := dut.dialCLI(ctx)
 	if err != nil {
 		return err
 	}
 
-	if _, err := cli.RunCommand(ctx, conf); err != nil {
+	if dut.dev.GetConfig().GetCliConfigMode() {
+		_, err := cli.Configure(ctx, conf)
+		return err
+	}
+	if _, err := cli.runSession(conf); err != nil {
 		return err
 	}
 	return nil
@@ -120,3 +222,398 @@ func resetGRIBI(ctx context.Context, dut *staticDUT) error {
 	_, err = gribi.Flush(ctx, req)
 	return err
 }
+
+func readJSON(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("%s does not contain valid JSON", path)
+	}
+	return data, nil
+}
+
+// gnmiReplace replaces the whole config of the DUT with the OpenConfig JSON in
+// the file.
+func gnmiReplace(ctx context.Context, dut *staticDUT, path string) error {
+	data, err := readJSON(path)
+	if err != nil {
+		return err
+	}
+	gnmi, err := dut.DialGNMI(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = gnmi.Set(ctx, &gpb.SetRequest{
+		Replace: []*gpb.Update{{
+			Path: &gpb.Path{Origin: "openconfig"},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: data}},
+		}},
+	})
+	return err
+}
+
+// gnoiFilePut copies the local file to the DUT.
+func gnoiFilePut(ctx context.Context, dut *staticDUT, put *bindpb.GNOIFilePut) error {
+	data, err := os.ReadFile(put.GetLocalFile())
+	if err != nil {
+		return err
+	}
+	perm := put.GetPermissions()
+	if perm == 0 {
+		perm = 0o644
+	}
+	gnoi, err := dut.DialGNOI(ctx)
+	if err != nil {
+		return err
+	}
+	stream, err := gnoi.File().Put(ctx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&fpb.PutRequest{
+		Request: &fpb.PutRequest_Open{Open: &fpb.PutRequest_Details{
+			RemoteFile:  put.GetRemoteFile(),
+			Permissions: perm,
+		}},
+	}); err != nil {
+		return err
+	}
+	const chunkSize = 64 * 1024
+	for rest := data; len(rest) > 0; {
+		n := min(len(rest), chunkSize)
+		if err := stream.Send(&fpb.PutRequest{
+			Request: &fpb.PutRequest_Contents{Contents: rest[:n]},
+		}); err != nil {
+			return err
+		}
+		rest = rest[n:]
+	}
+	sum := md5.Sum(data)
+	if err := stream.Send(&fpb.PutRequest{
+		Request: &fpb.PutRequest_Hash{Hash: &tpb.HashType{Method: tpb.HashType_MD5, Hash: sum[:]}},
+	}); err != nil {
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// gnoiReboot cold reboots the DUT, then waits for gNOI to stop and start
+// responding again.
+func gnoiReboot(ctx context.Context, dut *staticDUT, reboot *bindpb.GNOIReboot) error {
+	gnoi, err := dut.DialGNOI(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := gnoi.System().Reboot(ctx, &syspb.RebootRequest{
+		Method:  syspb.RebootMethod_COLD,
+		Message: reboot.GetMessage(),
+	}); err != nil {
+		return err
+	}
+	var wentDown bool
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, resetPollInterval)
+		_, err := gnoi.System().Time(pollCtx, &syspb.TimeRequest{})
+		cancel()
+		switch {
+		case err != nil && !wentDown && ctx.Err() == nil:
+			glog.Infof("Device %s went down for reboot: %v", dut.Name(), err)
+			wentDown = true
+		case err == nil && wentDown:
+			glog.Infof("Device %s is back from reboot", dut.Name())
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if wentDown {
+				return fmt.Errorf("device did not come back from reboot: %w", ctx.Err())
+			}
+			return fmt.Errorf("device did not go down for reboot: %w", ctx.Err())
+		case <-time.After(resetPollInterval):
+		}
+	}
+}
+
+// p4rtClear becomes the primary controller of the P4RT device and deletes all
+// its table entries.
+func p4rtClear(ctx context.Context, dut *staticDUT, pc *bindpb.P4RTClear) error {
+	electionID := &p4pb.Uint128{Low: pc.GetElectionId()}
+	if electionID.Low == 0 {
+		electionID.Low = 1
+	}
+	p4rt, err := dut.DialP4RT(ctx)
+	if err != nil {
+		return err
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := p4rt.StreamChannel(streamCtx)
+	if err != nil {
+		return err
+	}
+	if err := stream.Send(&p4pb.StreamMessageRequest{
+		Update: &p4pb.StreamMessageRequest_Arbitration{Arbitration: &p4pb.MasterArbitrationUpdate{
+			DeviceId:   pc.GetDeviceId(),
+			ElectionId: electionID,
+		}},
+	}); err != nil {
+		return err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if code := resp.GetArbitration().GetStatus().GetCode(); code != 0 {
+		return fmt.Errorf("could not become the primary controller: %v", resp.GetArbitration().GetStatus())
+	}
+
+	read, err := p4rt.Read(ctx, &p4pb.ReadRequest{
+		DeviceId: pc.GetDeviceId(),
+		Entities: []*p4pb.Entity{{Entity: &p4pb.Entity_TableEntry{TableEntry: &p4pb.TableEntry{}}}},
+	})
+	if err != nil {
+		return err
+	}
+	var updates []*p4pb.Update
+	for {
+		resp, err := read.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		for _, entity := range resp.GetEntities() {
+			updates = append(updates, &p4pb.Update{Type: p4pb.Update_DELETE, Entity: entity})
+		}
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+	_, err = p4rt.Write(ctx, &p4pb.WriteRequest{
+		DeviceId:   pc.GetDeviceId(),
+		ElectionId: electionID,
+		Updates:    updates,
+	})
+	return err
+}
+
+// gnsiAuthz rotates the authz policy in the file onto the DUT.
+func gnsiAuthz(ctx context.Context, dut *staticDUT, path string) error {
+	policy, err := readJSON(path)
+	if err != nil {
+		return err
+	}
+	gnsi, err := dut.DialGNSI(ctx)
+	if err != nil {
+		return err
+	}
+	stream, err := gnsi.Authz().Rotate(ctx)
+	if err != nil {
+		return err
+	}
+	now := nowFn()
+	if err := stream.Send(&authzpb.RotateAuthzRequest{
+		ForceOverwrite: true,
+		RotateRequest: &authzpb.RotateAuthzRequest_UploadRequest{UploadRequest: &authzpb.UploadRequest{
+			Version:   fmt.Sprintf("reset-%d", now.Unix()),
+			CreatedOn: uint64(now.Unix()),
+			Policy:    string(policy),
+		}},
+	}); err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.Send(&authzpb.RotateAuthzRequest{
+		RotateRequest: &authzpb.RotateAuthzRequest_FinalizeRotation{FinalizeRotation: &authzpb.FinalizeRequest{}},
+	}); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	return awaitEOF(stream)
+}
+
+// gnsiCertz rotates the certificate and trust bundle onto the DUT.
+func gnsiCertz(ctx context.Context, dut *staticDUT, certz *bindpb.GNSICertz) error {
+	cert, err := os.ReadFile(certz.GetCertFile())
+	if err != nil {
+		return err
+	}
+	key, err := os.ReadFile(certz.GetKeyFile())
+	if err != nil {
+		return err
+	}
+	bundle, err := os.ReadFile(certz.GetTrustBundleFile())
+	if err != nil {
+		return err
+	}
+	gnsi, err := dut.DialGNSI(ctx)
+	if err != nil {
+		return err
+	}
+	stream, err := gnsi.Certz().Rotate(ctx)
+	if err != nil {
+		return err
+	}
+	version := certz.GetVersion()
+	createdOn := uint64(nowFn().Unix())
+	if version == "" {
+		version = fmt.Sprintf("reset-%d", createdOn)
+	}
+	if err := stream.Send(&certzpb.RotateCertificateRequest{
+		ForceOverwrite: true,
+		SslProfileId:   certz.GetSslProfileId(),
+		RotateRequest: &certzpb.RotateCertificateRequest_Certificates{Certificates: &certzpb.UploadRequest{
+			Entities: []*certzpb.Entity{{
+				Version:   version,
+				CreatedOn: createdOn,
+				Entity: &certzpb.Entity_CertificateChain{CertificateChain: &certzpb.CertificateChain{
+					Certificate: &certzpb.Certificate{
+						Type:            certzpb.CertificateType_CERTIFICATE_TYPE_X509,
+						Encoding:        certzpb.CertificateEncoding_CERTIFICATE_ENCODING_PEM,
+						CertificateType: &certzpb.Certificate_RawCertificate{RawCertificate: cert},
+						PrivateKeyType:  &certzpb.Certificate_RawPrivateKey{RawPrivateKey: key},
+					},
+				}},
+			}, {
+				Version:   version,
+				CreatedOn: createdOn,
+				Entity: &certzpb.Entity_TrustBundle{TrustBundle: &certzpb.CertificateChain{
+					Certificate: &certzpb.Certificate{
+						Type:            certzpb.CertificateType_CERTIFICATE_TYPE_X509,
+						Encoding:        certzpb.CertificateEncoding_CERTIFICATE_ENCODING_PEM,
+						CertificateType: &certzpb.Certificate_RawCertificate{RawCertificate: bundle},
+					},
+				}},
+			}},
+		}},
+	}); err != nil {
+		return err
+	}
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.Send(&certzpb.RotateCertificateRequest{
+		SslProfileId:  certz.GetSslProfileId(),
+		RotateRequest: &certzpb.RotateCertificateRequest_FinalizeRotation{FinalizeRotation: &certzpb.FinalizeRequest{}},
+	}); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	return awaitEOF(stream)
+}
+
+// awaitEOF receives from the stream until the server ends it, so that the
+// finalize request is processed before the step's context is cancelled.
+func awaitEOF[T any](stream interface{ Recv() (T, error) }) error {
+	for {
+		if _, err := stream.Recv(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// verify polls the DUT until gNMI responds with the expected state values.
+func verify(ctx context.Context, dut *staticDUT, v *bindpb.ResetVerify) error {
+	want := v.GetGnmiState()
+	var paths []string
+	for path := range want {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	gpaths := make(map[string]*gpb.Path)
+	for _, path := range paths {
+		p, err := ygot.StringToStructuredPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid gnmi_state path %q: %w", path, err)
+		}
+		gpaths[path] = p
+	}
+
+	gnmi, err := dut.DialGNMI(ctx)
+	if err != nil {
+		return err
+	}
+	var lastErr error
+	for {
+		err := checkState(ctx, gnmi, paths, gpaths, want)
+		if err == nil {
+			return nil
+		}
+		// Keep the reason of the last check that the deadline did not cut short.
+		if lastErr == nil || ctx.Err() == nil {
+			lastErr = err
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("device is not healthy: %w", lastErr)
+		case <-time.After(resetPollInterval):
+		}
+	}
+}
+
+// checkState checks that gNMI responds and that the state paths have the
+// wanted values.
+func checkState(ctx context.Context, gnmi gpb.GNMIClient, paths []string, gpaths map[string]*gpb.Path, want map[string]string) error {
+	if len(paths) == 0 {
+		_, err := gnmi.Capabilities(ctx, &gpb.CapabilityRequest{})
+		return err
+	}
+	for _, path := range paths {
+		resp, err := gnmi.Get(ctx, &gpb.GetRequest{
+			Path:     []*gpb.Path{gpaths[path]},
+			Type:     gpb.GetRequest_STATE,
+			Encoding: gpb.Encoding_JSON_IETF,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		var updates []*gpb.Update
+		for _, n := range resp.GetNotification() {
+			updates = append(updates, n.GetUpdate()...)
+		}
+		if len(updates) != 1 {
+			return fmt.Errorf("%s: got %d values, want 1", path, len(updates))
+		}
+		got, err := stateString(updates[0].GetVal())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if got != want[path] {
+			return fmt.Errorf("%s: got %q, want %q", path, got, want[path])
+		}
+	}
+	return nil
+}
+
+// stateString returns a scalar value as a string, with JSON strings unquoted.
+func stateString(tv *gpb.TypedValue) (string, error) {
+	data := tv.GetJsonIetfVal()
+	if data == nil {
+		data = tv.GetJsonVal()
+	}
+	if data == nil {
+		v, err := value.ToScalar(tv)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprint(v), nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s, nil
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,815 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	fpb "github.com/openconfig/gnoi/file"
+	syspb "github.com/openconfig/gnoi/system"
+	tpb "github.com/openconfig/gnoi/types"
+	authzpb "github.com/openconfig/gnsi/authz"
+	certzpb "github.com/openconfig/gnsi/certz"
+	"github.com/openconfig/ondatra/binding"
+	"github.com/openconfig/ygot/ygot"
+	p4pb "github.com/p4lang/p4runtime/go/p4/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestResetSteps(t *testing.T) {
+	cfg := &bindpb.Configs{
+		Actions: []*bindpb.ResetAction{{
+			Action: &bindpb.ResetAction_GnmiReplaceFile{GnmiReplaceFile: "config.json"},
+		}, {
+			Timeout: 600,
+			Action:  &bindpb.ResetAction_GnoiReboot{GnoiReboot: &bindpb.GNOIReboot{}},
+		}, {
+			Action: &bindpb.ResetAction_Verify{Verify: &bindpb.ResetVerify{}},
+		}},
+	}
+	type step struct {
+		Name    string
+		Timeout time.Duration
+	}
+	var got []step
+	for _, s := range resetSteps(cfg) {
+		got = append(got, step{s.name, s.timeout})
+	}
+	want := []step{
+		{"cli", *resetActionTimeout},
+		{"gnmi_set_file", *resetActionTimeout},
+		{"gribi_flush", *resetActionTimeout},
+		{"gnmi_replace_file config.json", *resetActionTimeout},
+		{"gnoi_reboot", 10 * time.Minute},
+		{"verify", *resetActionTimeout},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("resetSteps() got unexpected diff (-want, +got):\n%s", diff)
+	}
+}
+
+func TestRunResetSteps(t *testing.T) {
+	var ran []string
+	step := func(name string, timeout time.Duration, err error) resetStep {
+		return resetStep{name: name, timeout: timeout, fn: func(ctx context.Context, _ *staticDUT) error {
+			ran = append(ran, name)
+			if errors.Is(err, context.DeadlineExceeded) {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return err
+		}}
+	}
+
+	tests := []struct {
+		desc    string
+		steps   []resetStep
+		wantRan []string
+		wantErr string
+	}{{
+		desc:    "success",
+		steps:   []resetStep{step("a", time.Minute, nil), step("b", time.Minute, nil)},
+		wantRan: []string{"a", "b"},
+	}, {
+		desc:    "failure",
+		steps:   []resetStep{step("a", time.Minute, nil), step("b", time.Minute, errors.New("broken")), step("c", time.Minute, nil)},
+		wantRan: []string{"a", "b"},
+		wantErr: "reset step 2 (b) failed: broken",
+	}, {
+		desc:    "timeout",
+		steps:   []resetStep{step("a", time.Millisecond, context.DeadlineExceeded), step("b", time.Minute, nil)},
+		wantRan: []string{"a"},
+		wantErr: "reset step 1 (a) timed out after 1ms",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ran = nil
+			err := runResetSteps(context.Background(), &staticDUT{}, test.steps)
+			if (err == nil) != (test.wantErr == "") || err != nil && !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("runResetSteps() got error %v, want error containing %q", err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.wantRan, ran); diff != "" {
+				t.Errorf("runResetSteps() ran unexpected steps (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStateString(t *testing.T) {
+	tests := []struct {
+		val  *gpb.TypedValue
+		want string
+	}{
+		{&gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "dut"}}, "dut"},
+		{&gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 42}}, "42"},
+		{&gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: true}}, "true"},
+		{&gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"openconfig-platform-types:ACTIVE"`)}}, "openconfig-platform-types:ACTIVE"},
+		{&gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte("9000\n")}}, "9000"},
+	}
+	for _, test := range tests {
+		got, err := stateString(test.val)
+		if err != nil {
+			t.Errorf("stateString(%v) got error: %v", test.val, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("stateString(%v) got %q, want %q", test.val, got, test.want)
+		}
+	}
+}
+
+// serveDUT serves the registered services on a local port and returns a DUT
+// that dials them.
+func serveDUT(t *testing.T, register func(*grpc.Server)) *staticDUT {
+	t.Helper()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	register(srv)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return &staticDUT{
+		AbstractDUT: &binding.AbstractDUT{Dims: &binding.Dims{Name: "dut"}},
+		r:           resolver{&bindpb.Binding{}},
+		dev: &bindpb.Device{
+			Name:    "dut",
+			Options: &bindpb.Options{Target: lis.Addr().String(), Insecure: true},
+		},
+	}
+}
+
+// writeTemp writes the contents to a file in a temporary directory and
+// returns its path.
+func writeTemp(t *testing.T, name string, contents []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, contents, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// checkErr reports whether err matches wantErr, which is a substring of the
+// wanted error or empty for no error.
+func checkErr(t *testing.T, call string, err error, wantErr string) {
+	t.Helper()
+	if (err == nil) != (wantErr == "") || err != nil && !strings.Contains(err.Error(), wantErr) {
+		t.Errorf("%s got error %v, want error containing %q", call, err, wantErr)
+	}
+}
+
+// fakeGNMI is a fake gNMI server, which records the SetRequests and answers
+// Gets of the state paths from a map.
+type fakeGNMI struct {
+	gpb.UnimplementedGNMIServer
+	setErr error
+	state  map[string]string // JSON values by path.
+
+	mu   sync.Mutex
+	sets []*gpb.SetRequest
+}
+
+func (f *fakeGNMI) Capabilities(context.Context, *gpb.CapabilityRequest) (*gpb.CapabilityResponse, error) {
+	return &gpb.CapabilityResponse{}, nil
+}
+
+func (f *fakeGNMI) Get(_ context.Context, req *gpb.GetRequest) (*gpb.GetResponse, error) {
+	path, err := ygot.PathToString(req.GetPath()[0])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	val, ok := f.state[path]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no state at %s", path)
+	}
+	return &gpb.GetResponse{Notification: []*gpb.Notification{{
+		Update: []*gpb.Update{{
+			Path: req.GetPath()[0],
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(val)}},
+		}},
+	}}}, nil
+}
+
+func (f *fakeGNMI) Set(_ context.Context, req *gpb.SetRequest) (*gpb.SetResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sets = append(f.sets, req)
+	if f.setErr != nil {
+		return nil, f.setErr
+	}
+	return &gpb.SetResponse{}, nil
+}
+
+func TestGNMIReplace(t *testing.T) {
+	config := `{"openconfig-system:system": {"config": {"hostname": "dut"}}}`
+	wantSet := &gpb.SetRequest{
+		Replace: []*gpb.Update{{
+			Path: &gpb.Path{Origin: "openconfig"},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(config)}},
+		}},
+	}
+	tests := []struct {
+		desc     string
+		contents string
+		setErr   error
+		wantSets []*gpb.SetRequest
+		wantErr  string
+	}{{
+		desc:     "success",
+		contents: config,
+		wantSets: []*gpb.SetRequest{wantSet},
+	}, {
+		desc:     "invalid json",
+		contents: "hostname dut",
+		wantErr:  "does not contain valid JSON",
+	}, {
+		desc:     "set error",
+		contents: config,
+		setErr:   status.Error(codes.InvalidArgument, "bad config"),
+		wantSets: []*gpb.SetRequest{wantSet},
+		wantErr:  "bad config",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			gnmi := &fakeGNMI{setErr: test.setErr}
+			dut := serveDUT(t, func(srv *grpc.Server) { gpb.RegisterGNMIServer(srv, gnmi) })
+			path := writeTemp(t, "config.json", []byte(test.contents))
+
+			err := gnmiReplace(context.Background(), dut, path)
+			checkErr(t, "gnmiReplace()", err, test.wantErr)
+			if diff := cmp.Diff(test.wantSets, gnmi.sets, protocmp.Transform()); diff != "" {
+				t.Errorf("gnmiReplace() sent unexpected SetRequests (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeFile is a fake gNOI File server, which records the PutRequests.
+type fakeFile struct {
+	fpb.UnimplementedFileServer
+	putErr error
+
+	mu   sync.Mutex
+	puts []*fpb.PutRequest
+}
+
+func (f *fakeFile) Put(stream fpb.File_PutServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		f.mu.Lock()
+		f.puts = append(f.puts, req)
+		f.mu.Unlock()
+		if f.putErr != nil {
+			return f.putErr
+		}
+		if req.GetHash() != nil {
+			return stream.SendAndClose(&fpb.PutResponse{})
+		}
+	}
+}
+
+func TestGNOIFilePut(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 5000) // Two chunks.
+	sum := md5.Sum(data)
+	wantPuts := func(perm uint32) []*fpb.PutRequest {
+		return []*fpb.PutRequest{
+			{Request: &fpb.PutRequest_Open{Open: &fpb.PutRequest_Details{RemoteFile: "/tmp/image", Permissions: perm}}},
+			{Request: &fpb.PutRequest_Contents{Contents: data[:64*1024]}},
+			{Request: &fpb.PutRequest_Contents{Contents: data[64*1024:]}},
+			{Request: &fpb.PutRequest_Hash{Hash: &tpb.HashType{Method: tpb.HashType_MD5, Hash: sum[:]}}},
+		}
+	}
+	tests := []struct {
+		desc     string
+		perm     uint32
+		putErr   error
+		wantPuts []*fpb.PutRequest
+		wantErr  string
+	}{{
+		desc:     "default permissions",
+		wantPuts: wantPuts(0o644),
+	}, {
+		desc:     "permissions",
+		perm:     0o600,
+		wantPuts: wantPuts(0o600),
+	}, {
+		desc:     "put error",
+		putErr:   status.Error(codes.PermissionDenied, "read-only"),
+		wantPuts: wantPuts(0o644)[:1],
+		wantErr:  "read-only",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			file := &fakeFile{putErr: test.putErr}
+			dut := serveDUT(t, func(srv *grpc.Server) { fpb.RegisterFileServer(srv, file) })
+			put := &bindpb.GNOIFilePut{
+				LocalFile:   writeTemp(t, "image", data),
+				RemoteFile:  "/tmp/image",
+				Permissions: test.perm,
+			}
+
+			err := gnoiFilePut(context.Background(), dut, put)
+			checkErr(t, "gnoiFilePut()", err, test.wantErr)
+			file.mu.Lock()
+			defer file.mu.Unlock()
+			if diff := cmp.Diff(test.wantPuts, file.puts, protocmp.Transform()); diff != "" {
+				t.Errorf("gnoiFilePut() sent unexpected PutRequests (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("missing local file", func(t *testing.T) {
+		dut := serveDUT(t, func(srv *grpc.Server) { fpb.RegisterFileServer(srv, &fakeFile{}) })
+		put := &bindpb.GNOIFilePut{LocalFile: filepath.Join(t.TempDir(), "missing"), RemoteFile: "/tmp/image"}
+		if err := gnoiFilePut(context.Background(), dut, put); err == nil {
+			t.Errorf("gnoiFilePut() of a missing file got no error, want error")
+		}
+	})
+}
+
+// fakeSystem is a fake gNOI System server, which records the reboots and
+// fails the Time requests that follow a reboot while the device is down.
+type fakeSystem struct {
+	syspb.UnimplementedSystemServer
+	rebootErr error
+
+	mu      sync.Mutex
+	down    int // Number of Time requests to fail after a reboot; -1 to fail all.
+	reboots []*syspb.RebootRequest
+}
+
+func (f *fakeSystem) Reboot(_ context.Context, req *syspb.RebootRequest) (*syspb.RebootResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reboots = append(f.reboots, req)
+	if f.rebootErr != nil {
+		return nil, f.rebootErr
+	}
+	return &syspb.RebootResponse{}, nil
+}
+
+func (f *fakeSystem) Time(context.Context, *syspb.TimeRequest) (*syspb.TimeResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.reboots) > 0 && f.down != 0 {
+		if f.down > 0 {
+			f.down--
+		}
+		return nil, status.Error(codes.Unavailable, "rebooting")
+	}
+	return &syspb.TimeResponse{Time: uint64(time.Now().UnixNano())}, nil
+}
+
+func TestGNOIReboot(t *testing.T) {
+	origInterval := resetPollInterval
+	defer func() { resetPollInterval = origInterval }()
+	resetPollInterval = 50 * time.Millisecond // Also the timeout of each poll.
+
+	wantReboot := &syspb.RebootRequest{Method: syspb.RebootMethod_COLD, Message: "reset"}
+	tests := []struct {
+		desc      string
+		down      int
+		rebootErr error
+		wantErr   string
+	}{{
+		desc: "success",
+		down: 3,
+	}, {
+		desc:    "never goes down",
+		wantErr: "device did not go down for reboot",
+	}, {
+		desc:    "never comes back",
+		down:    -1,
+		wantErr: "device did not come back from reboot",
+	}, {
+		desc:      "reboot error",
+		rebootErr: status.Error(codes.Unimplemented, "no reboot"),
+		wantErr:   "no reboot",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			system := &fakeSystem{down: test.down, rebootErr: test.rebootErr}
+			dut := serveDUT(t, func(srv *grpc.Server) { syspb.RegisterSystemServer(srv, system) })
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			err := gnoiReboot(ctx, dut, &bindpb.GNOIReboot{Message: "reset"})
+			checkErr(t, "gnoiReboot()", err, test.wantErr)
+			system.mu.Lock()
+			defer system.mu.Unlock()
+			if diff := cmp.Diff([]*syspb.RebootRequest{wantReboot}, system.reboots, protocmp.Transform()); diff != "" {
+				t.Errorf("gnoiReboot() sent unexpected RebootRequests (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeP4RT is a fake P4Runtime server, which answers the arbitration with a
+// status code, reads its table entries, and records the writes.
+type fakeP4RT struct {
+	p4pb.UnimplementedP4RuntimeServer
+	code     codes.Code // Of the arbitration responses.
+	entities []*p4pb.Entity
+	writeErr error
+
+	mu           sync.Mutex
+	arbitrations []*p4pb.MasterArbitrationUpdate
+	writes       []*p4pb.WriteRequest
+}
+
+func (f *fakeP4RT) StreamChannel(stream p4pb.P4Runtime_StreamChannelServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil // The client went away.
+		}
+		arb := req.GetArbitration()
+		f.mu.Lock()
+		f.arbitrations = append(f.arbitrations, arb)
+		f.mu.Unlock()
+		if err := stream.Send(&p4pb.StreamMessageResponse{
+			Update: &p4pb.StreamMessageResponse_Arbitration{Arbitration: &p4pb.MasterArbitrationUpdate{
+				DeviceId:   arb.GetDeviceId(),
+				ElectionId: arb.GetElectionId(),
+				Status:     status.New(f.code, f.code.String()).Proto(),
+			}},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (f *fakeP4RT) Read(_ *p4pb.ReadRequest, stream p4pb.P4Runtime_ReadServer) error {
+	return stream.Send(&p4pb.ReadResponse{Entities: f.entities})
+}
+
+func (f *fakeP4RT) Write(_ context.Context, req *p4pb.WriteRequest) (*p4pb.WriteResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.writes = append(f.writes, req)
+	if f.writeErr != nil {
+		return nil, f.writeErr
+	}
+	return &p4pb.WriteResponse{}, nil
+}
+
+func TestP4RTClear(t *testing.T) {
+	entity := &p4pb.Entity{Entity: &p4pb.Entity_TableEntry{TableEntry: &p4pb.TableEntry{TableId: 1, Priority: 10}}}
+	wantWrite := func(low uint64) *p4pb.WriteRequest {
+		return &p4pb.WriteRequest{
+			DeviceId:   7,
+			ElectionId: &p4pb.Uint128{Low: low},
+			Updates:    []*p4pb.Update{{Type: p4pb.Update_DELETE, Entity: entity}},
+		}
+	}
+	tests := []struct {
+		desc       string
+		electionID uint64
+		code       codes.Code
+		entities   []*p4pb.Entity
+		writeErr   error
+		wantWrites []*p4pb.WriteRequest
+		wantErr    string
+	}{{
+		desc:       "default election id",
+		entities:   []*p4pb.Entity{entity},
+		wantWrites: []*p4pb.WriteRequest{wantWrite(1)},
+	}, {
+		desc:       "election id",
+		electionID: 5,
+		entities:   []*p4pb.Entity{entity},
+		wantWrites: []*p4pb.WriteRequest{wantWrite(5)},
+	}, {
+		desc: "no entries",
+	}, {
+		desc:     "not primary",
+		code:     codes.AlreadyExists,
+		entities: []*p4pb.Entity{entity},
+		wantErr:  "could not become the primary controller",
+	}, {
+		desc:       "write error",
+		entities:   []*p4pb.Entity{entity},
+		writeErr:   status.Error(codes.PermissionDenied, "not primary"),
+		wantWrites: []*p4pb.WriteRequest{wantWrite(1)},
+		wantErr:    "not primary",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			p4rt := &fakeP4RT{code: test.code, entities: test.entities, writeErr: test.writeErr}
+			dut := serveDUT(t, func(srv *grpc.Server) { p4pb.RegisterP4RuntimeServer(srv, p4rt) })
+
+			err := p4rtClear(context.Background(), dut, &bindpb.P4RTClear{DeviceId: 7, ElectionId: test.electionID})
+			checkErr(t, "p4rtClear()", err, test.wantErr)
+			p4rt.mu.Lock()
+			defer p4rt.mu.Unlock()
+			if len(p4rt.arbitrations) != 1 || p4rt.arbitrations[0].GetDeviceId() != 7 {
+				t.Errorf("p4rtClear() sent arbitrations %v, want one for device 7", p4rt.arbitrations)
+			}
+			if diff := cmp.Diff(test.wantWrites, p4rt.writes, protocmp.Transform()); diff != "" {
+				t.Errorf("p4rtClear() sent unexpected WriteRequests (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeAuthz is a fake gNSI Authz server, which records the rotate requests.
+// It ends the stream once it has received the finalize request.
+type fakeAuthz struct {
+	authzpb.UnimplementedAuthzServer
+	uploadErr error
+
+	mu   sync.Mutex
+	reqs []*authzpb.RotateAuthzRequest
+}
+
+func (f *fakeAuthz) Rotate(stream authzpb.Authz_RotateServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		f.mu.Lock()
+		f.reqs = append(f.reqs, req)
+		f.mu.Unlock()
+		switch {
+		case req.GetFinalizeRotation() != nil:
+			return nil
+		case f.uploadErr != nil:
+			return f.uploadErr
+		}
+		if err := stream.Send(&authzpb.RotateAuthzResponse{
+			RotateResponse: &authzpb.RotateAuthzResponse_UploadResponse{UploadResponse: &authzpb.UploadResponse{}},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func TestGNSIAuthz(t *testing.T) {
+	origNowFn := nowFn
+	defer func() { nowFn = origNowFn }()
+	now := time.Unix(1700000000, 0)
+	nowFn = func() time.Time { return now }
+
+	policy := `{"name": "reset", "allow_rules": []}`
+	upload := &authzpb.RotateAuthzRequest{
+		ForceOverwrite: true,
+		RotateRequest: &authzpb.RotateAuthzRequest_UploadRequest{UploadRequest: &authzpb.UploadRequest{
+			Version:   "reset-1700000000",
+			CreatedOn: 1700000000,
+			Policy:    policy,
+		}},
+	}
+	finalize := &authzpb.RotateAuthzRequest{
+		RotateRequest: &authzpb.RotateAuthzRequest_FinalizeRotation{FinalizeRotation: &authzpb.FinalizeRequest{}},
+	}
+	tests := []struct {
+		desc      string
+		contents  string
+		uploadErr error
+		wantReqs  []*authzpb.RotateAuthzRequest
+		wantErr   string
+	}{{
+		desc:     "success",
+		contents: policy,
+		wantReqs: []*authzpb.RotateAuthzRequest{upload, finalize},
+	}, {
+		desc:     "invalid json",
+		contents: "allow all",
+		wantErr:  "does not contain valid JSON",
+	}, {
+		desc:      "upload error",
+		contents:  policy,
+		uploadErr: status.Error(codes.InvalidArgument, "bad policy"),
+		wantReqs:  []*authzpb.RotateAuthzRequest{upload},
+		wantErr:   "bad policy",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			authz := &fakeAuthz{uploadErr: test.uploadErr}
+			dut := serveDUT(t, func(srv *grpc.Server) { authzpb.RegisterAuthzServer(srv, authz) })
+			path := writeTemp(t, "policy.json", []byte(test.contents))
+
+			err := gnsiAuthz(context.Background(), dut, path)
+			checkErr(t, "gnsiAuthz()", err, test.wantErr)
+			// The rotation must be done, not just sent, when gnsiAuthz returns.
+			authz.mu.Lock()
+			defer authz.mu.Unlock()
+			if diff := cmp.Diff(test.wantReqs, authz.reqs, protocmp.Transform()); diff != "" {
+				t.Errorf("gnsiAuthz() sent unexpected requests (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// fakeCertz is a fake gNSI Certz server, which records the rotate requests.
+// It ends the stream once it has received the finalize request.
+type fakeCertz struct {
+	certzpb.UnimplementedCertzServer
+	uploadErr error
+
+	mu   sync.Mutex
+	reqs []*certzpb.RotateCertificateRequest
+}
+
+func (f *fakeCertz) Rotate(stream certzpb.Certz_RotateServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		f.mu.Lock()
+		f.reqs = append(f.reqs, req)
+		f.mu.Unlock()
+		switch {
+		case req.GetFinalizeRotation() != nil:
+			return nil
+		case f.uploadErr != nil:
+			return f.uploadErr
+		}
+		if err := stream.Send(&certzpb.RotateCertificateResponse{
+			RotateResponse: &certzpb.RotateCertificateResponse_Certificates{Certificates: &certzpb.UploadResponse{}},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func TestGNSICertz(t *testing.T) {
+	origNowFn := nowFn
+	defer func() { nowFn = origNowFn }()
+	nowFn = func() time.Time { return time.Unix(1700000000, 0) }
+
+	dir := t.TempDir()
+	files := map[string]string{"cert.pem": "CERT", "key.pem": "KEY", "ca.pem": "CA"}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	certz := &bindpb.GNSICertz{
+		SslProfileId:    "system_default_profile",
+		CertFile:        filepath.Join(dir, "cert.pem"),
+		KeyFile:         filepath.Join(dir, "key.pem"),
+		TrustBundleFile: filepath.Join(dir, "ca.pem"),
+	}
+
+	// summarize describes a rotate request by its profile, type, and the
+	// versions and contents of its entities.
+	summarize := func(reqs []*certzpb.RotateCertificateRequest) []string {
+		var got []string
+		for _, req := range reqs {
+			s := req.GetSslProfileId()
+			if req.GetFinalizeRotation() != nil {
+				got = append(got, s+" finalize")
+				continue
+			}
+			s += fmt.Sprintf(" upload force=%v", req.GetForceOverwrite())
+			for _, e := range req.GetCertificates().GetEntities() {
+				switch {
+				case e.GetCertificateChain() != nil:
+					c := e.GetCertificateChain().GetCertificate()
+					s += fmt.Sprintf(" cert=%s/%s/%s", e.GetVersion(), c.GetRawCertificate(), c.GetRawPrivateKey())
+				case e.GetTrustBundle() != nil:
+					s += fmt.Sprintf(" bundle=%s/%s", e.GetVersion(), e.GetTrustBundle().GetCertificate().GetRawCertificate())
+				}
+			}
+			got = append(got, s)
+		}
+		return got
+	}
+
+	tests := []struct {
+		desc      string
+		version   string
+		uploadErr error
+		want      []string
+		wantErr   string
+	}{{
+		desc: "default version",
+		want: []string{
+			"system_default_profile upload force=true cert=reset-1700000000/CERT/KEY bundle=reset-1700000000/CA",
+			"system_default_profile finalize",
+		},
+	}, {
+		desc:    "version",
+		version: "v2",
+		want: []string{
+			"system_default_profile upload force=true cert=v2/CERT/KEY bundle=v2/CA",
+			"system_default_profile finalize",
+		},
+	}, {
+		desc:      "upload error",
+		uploadErr: status.Error(codes.InvalidArgument, "bad certificate"),
+		want: []string{
+			"system_default_profile upload force=true cert=reset-1700000000/CERT/KEY bundle=reset-1700000000/CA",
+		},
+		wantErr: "bad certificate",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			fake := &fakeCertz{uploadErr: test.uploadErr}
+			dut := serveDUT(t, func(srv *grpc.Server) { certzpb.RegisterCertzServer(srv, fake) })
+			certz := proto.Clone(certz).(*bindpb.GNSICertz)
+			certz.Version = test.version
+
+			err := gnsiCertz(context.Background(), dut, certz)
+			checkErr(t, "gnsiCertz()", err, test.wantErr)
+			fake.mu.Lock()
+			defer fake.mu.Unlock()
+			if diff := cmp.Diff(test.want, summarize(fake.reqs)); diff != "" {
+				t.Errorf("gnsiCertz() sent unexpected requests (-want, +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("missing key file", func(t *testing.T) {
+		dut := serveDUT(t, func(srv *grpc.Server) { certzpb.RegisterCertzServer(srv, &fakeCertz{}) })
+		certz := proto.Clone(certz).(*bindpb.GNSICertz)
+		certz.KeyFile = filepath.Join(dir, "missing.pem")
+		if err := gnsiCertz(context.Background(), dut, certz); err == nil {
+			t.Errorf("gnsiCertz() with a missing key file got no error, want error")
+		}
+	})
+}
+
+func TestVerify(t *testing.T) {
+	origInterval := resetPollInterval
+	defer func() { resetPollInterval = origInterval }()
+	resetPollInterval = time.Millisecond
+
+	state := map[string]string{
+		"/system/state/hostname":                     `"dut"`,
+		"/interfaces/interface[name=eth0]/state/mtu": "9000",
+	}
+	tests := []struct {
+		desc    string
+		want    map[string]string
+		wantErr string
+	}{{
+		desc: "responds",
+	}, {
+		desc: "state",
+		want: map[string]string{
+			"/system/state/hostname":                     "dut",
+			"/interfaces/interface[name=eth0]/state/mtu": "9000",
+		},
+	}, {
+		desc:    "wrong value",
+		want:    map[string]string{"/system/state/hostname": "other"},
+		wantErr: `device is not healthy: /system/state/hostname: got "dut", want "other"`,
+	}, {
+		desc:    "missing path",
+		want:    map[string]string{"/system/state/domain-name": "example.com"},
+		wantErr: "no state at /system/state/domain-name",
+	}, {
+		desc:    "invalid path",
+		want:    map[string]string{"/system/state[name]": "dut"},
+		wantErr: "invalid gnmi_state path",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			dut := serveDUT(t, func(srv *grpc.Server) { gpb.RegisterGNMIServer(srv, &fakeGNMI{state: state}) })
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			err := verify(ctx, dut, &bindpb.ResetVerify{GnmiState: test.want})
+			checkErr(t, "verify()", err, test.wantErr)
+		})
+	}
+}
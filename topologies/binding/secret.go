@@ -0,0 +1,180 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// secretCommandTimeout is how long a command may take to print a secret.
+	secretCommandTimeout = 30 * time.Second
+	// defaultTokenRefresh is how long before a token expires to read it again.
+	defaultTokenRefresh = time.Minute
+)
+
+// readSecret reads the credential from its source.
+func readSecret(s *bindpb.Secret) (string, error) {
+	switch src := s.GetSource().(type) {
+	case *bindpb.Secret_Env:
+		v, ok := os.LookupEnv(src.Env)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", src.Env)
+		}
+		return v, nil
+	case *bindpb.Secret_File:
+		data, err := os.ReadFile(src.File)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	case *bindpb.Secret_Command:
+		args := src.Command.GetArgs()
+		if len(args) == 0 {
+			return "", errors.New("command has no args")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), secretCommandTimeout)
+		defer cancel()
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("command %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		return strings.TrimSpace(string(out)), nil
+	default:
+		return "", errors.New("no source")
+	}
+}
+
+// secrets caches the credentials read by readCachedSecret, by their source.
+var secrets sync.Map
+
+// cachedSecret is a credential read by readCachedSecret.
+type cachedSecret struct {
+	once  sync.Once
+	value string
+	err   error
+}
+
+// readCachedSecret reads the credential from its source once, and returns the
+// same credential, or error, every time after.  This keeps a command from
+// running for every connection to every device.
+func readCachedSecret(s *bindpb.Secret) (string, error) {
+	key, err := proto.MarshalOptions{Deterministic: true}.Marshal(s)
+	if err != nil {
+		return readSecret(s)
+	}
+	v, _ := secrets.LoadOrStore(string(key), new(cachedSecret))
+	cs := v.(*cachedSecret)
+	cs.once.Do(func() {
+		cs.value, cs.err = readSecret(s)
+	})
+	return cs.value, cs.err
+}
+
+// readCredentials returns the username and password of the options, read from
+// their sources if set.  Each source is only read once.
+func readCredentials(bopts *bindpb.Options) (string, string, error) {
+	username, password := bopts.GetUsername(), bopts.GetPassword()
+	var err error
+	if s := bopts.GetUsernameFrom(); s != nil {
+		if username, err = readCachedSecret(s); err != nil {
+			return "", "", fmt.Errorf("could not read username: %w", err)
+		}
+	}
+	if s := bopts.GetPasswordFrom(); s != nil {
+		if password, err = readCachedSecret(s); err != nil {
+			return "", "", fmt.Errorf("could not read password: %w", err)
+		}
+	}
+	return username, password, nil
+}
+
+// tokenCreds implements the grpc.PerRPCCredentials interface with a bearer
+// token, which is read from its source by the first call and again shortly
+// before it expires.
+type tokenCreds struct {
+	src    *bindpb.Token
+	secure bool
+
+	mu      sync.Mutex
+	token   string
+	refresh time.Time // When to read the token again, or zero if never.
+}
+
+func (c *tokenCreds) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token == "" || (!c.refresh.IsZero() && !nowFn().Before(c.refresh)) {
+		if err := c.read(); err != nil {
+			return nil, fmt.Errorf("could not read token: %w", err)
+		}
+	}
+	return map[string]string{"authorization": "Bearer " + c.token}, nil
+}
+
+func (c *tokenCreds) RequireTransportSecurity() bool {
+	return c.secure
+}
+
+// read reads the token and works out when to read it again.  It must be called
+// with mu held.
+func (c *tokenCreds) read() error {
+	raw, err := readSecret(c.src.GetSource())
+	if err != nil {
+		return err
+	}
+	now := nowFn()
+	token, expiry := raw, time.Time{}
+	var obj struct {
+		Token  string    `json:"token"`
+		Expiry time.Time `json:"expiry"`
+	}
+	if strings.HasPrefix(raw, "{") {
+		if err := json.Unmarshal([]byte(raw), &obj); err != nil {
+			return fmt.Errorf("could not parse token JSON: %w", err)
+		}
+		token, expiry = obj.Token, obj.Expiry
+	}
+	if token == "" {
+		return errors.New("token is empty")
+	}
+	if expiry.IsZero() && c.src.GetLifetime() > 0 {
+		expiry = now.Add(time.Duration(c.src.GetLifetime()) * time.Second)
+	}
+	c.token, c.refresh = token, time.Time{}
+	if !expiry.IsZero() {
+		before := time.Duration(c.src.GetRefresh()) * time.Second
+		if before == 0 {
+			before = min(defaultTokenRefresh, expiry.Sub(now)/2)
+		}
+		c.refresh = expiry.Add(-before)
+	}
+	return nil
+}
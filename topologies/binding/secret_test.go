@@ -0,0 +1,194 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+)
+
+func envSecret(name string) *bindpb.Secret {
+	return &bindpb.Secret{Source: &bindpb.Secret_Env{Env: name}}
+}
+
+func fileSecret(file string) *bindpb.Secret {
+	return &bindpb.Secret{Source: &bindpb.Secret_File{File: file}}
+}
+
+func commandSecret(args ...string) *bindpb.Secret {
+	return &bindpb.Secret{Source: &bindpb.Secret_Command{Command: &bindpb.Command{Args: args}}}
+}
+
+func TestReadSecret(t *testing.T) {
+	t.Setenv("FP_BINDING_TEST_PASSWORD", "from env")
+	file := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(file, []byte("from file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		desc    string
+		secret  *bindpb.Secret
+		want    string
+		wantErr string
+	}{{
+		desc:   "env",
+		secret: envSecret("FP_BINDING_TEST_PASSWORD"),
+		want:   "from env",
+	}, {
+		desc:    "env unset",
+		secret:  envSecret("FP_BINDING_TEST_UNSET"),
+		wantErr: "is not set",
+	}, {
+		desc:   "file",
+		secret: fileSecret(file),
+		want:   "from file",
+	}, {
+		desc:    "file missing",
+		secret:  fileSecret(filepath.Join(t.TempDir(), "missing")),
+		wantErr: "no such file",
+	}, {
+		desc:   "command",
+		secret: commandSecret("echo", "  from command  "),
+		want:   "from command",
+	}, {
+		desc:    "command failed",
+		secret:  commandSecret("sh", "-c", "echo no credential >&2; exit 1"),
+		wantErr: "no credential",
+	}, {
+		desc:    "command without args",
+		secret:  commandSecret(),
+		wantErr: "no args",
+	}, {
+		desc:    "no source",
+		secret:  &bindpb.Secret{},
+		wantErr: "no source",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := readSecret(tt.secret)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("readSecret() got error %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readSecret() got error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("readSecret() got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCredentials(t *testing.T) {
+	t.Setenv("FP_BINDING_TEST_PASSWORD", "secret")
+	username, password, err := readCredentials(&bindpb.Options{
+		Username:     "admin",
+		Password:     "plain",
+		PasswordFrom: envSecret("FP_BINDING_TEST_PASSWORD"),
+	})
+	if err != nil {
+		t.Fatalf("readCredentials() got error: %v", err)
+	}
+	if username != "admin" || password != "secret" {
+		t.Errorf("readCredentials() got %q, %q, want %q, %q", username, password, "admin", "secret")
+	}
+}
+
+func TestReadCredentials_Cached(t *testing.T) {
+	runs := filepath.Join(t.TempDir(), "runs")
+	bopts := &bindpb.Options{
+		Username:     "admin",
+		PasswordFrom: commandSecret("sh", "-c", "echo run >> "+runs+"; echo secret"),
+	}
+	for i := 0; i < 3; i++ {
+		if _, password, err := readCredentials(bopts); err != nil || password != "secret" {
+			t.Fatalf("readCredentials() got password %q, error %v, want %q", password, err, "secret")
+		}
+	}
+	data, err := os.ReadFile(runs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(data), "run"); got != 1 {
+		t.Errorf("readCredentials() ran the command %d times, want once", got)
+	}
+}
+
+func TestTokenCreds(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	origNowFn := nowFn
+	defer func() { nowFn = origNowFn }()
+	nowFn = func() time.Time { return now }
+
+	file := filepath.Join(t.TempDir(), "token")
+	writeToken := func(token string) {
+		t.Helper()
+		if err := os.WriteFile(file, []byte(token), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wantToken := func(c *tokenCreds, want string) {
+		t.Helper()
+		md, err := c.GetRequestMetadata(context.Background())
+		if err != nil {
+			t.Fatalf("GetRequestMetadata() got error: %v", err)
+		}
+		if got := md["authorization"]; got != "Bearer "+want {
+			t.Errorf("GetRequestMetadata() got authorization %q, want %q", got, "Bearer "+want)
+		}
+	}
+
+	t.Run("lifetime", func(t *testing.T) {
+		writeToken("token1\n")
+		c := &tokenCreds{src: &bindpb.Token{Source: fileSecret(file), Lifetime: 600}}
+		wantToken(c, "token1")
+		writeToken("token2\n")
+		now = now.Add(8 * time.Minute)
+		wantToken(c, "token1")
+		now = now.Add(time.Minute) // Within a minute of expiry.
+		wantToken(c, "token2")
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		writeToken(`{"token": "token3", "expiry": "` + now.Add(time.Hour).Format(time.RFC3339) + `"}`)
+		c := &tokenCreds{src: &bindpb.Token{Source: fileSecret(file), Refresh: 300}}
+		wantToken(c, "token3")
+		writeToken("token4")
+		now = now.Add(54 * time.Minute)
+		wantToken(c, "token3")
+		now = now.Add(time.Minute) // Within five minutes of expiry.
+		wantToken(c, "token4")
+		now = now.Add(24 * time.Hour)
+		wantToken(c, "token4") // No expiry and no lifetime.
+	})
+
+	t.Run("error", func(t *testing.T) {
+		writeToken(`{"expiry": "` + now.Add(time.Hour).Format(time.RFC3339) + `"}`)
+		c := &tokenCreds{src: &bindpb.Token{Source: fileSecret(file)}}
+		if _, err := c.GetRequestMetadata(context.Background()); err == nil {
+			t.Errorf("GetRequestMetadata() of an empty token got no error, want error")
+		}
+	})
+}
@@ -0,0 +1,330 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	opb "github.com/openconfig/ondatra/proto"
+	"golang.org/x/crypto/ssh"
+)
+
+// shellIdleTimeout is how long a shell may be silent before it shows a prompt,
+// after which the command fails.
+var shellIdleTimeout = time.Minute
+
+// shellVendor describes the interactive CLI of a vendor.
+type shellVendor struct {
+	prompt    *regexp.Regexp // Matches the prompt, which ends the output of a command.
+	noise     *regexp.Regexp // Matches the lines shown around the prompt, which are not output; may be nil.
+	errors    *regexp.Regexp // Matches the output lines that report an error.
+	enable    string         // Command to enter privileged mode from a ">" prompt, if any.
+	init      []string       // Commands run when the shell starts, e.g. to disable pagination.
+	configure string         // Command to enter configuration mode.
+	commit    []string       // Commands to commit the configuration and leave configuration mode.
+	abort     []string       // Commands to discard the configuration and leave configuration mode.
+}
+
+// shellVendors are the vendors whose CLI is run in an interactive shell.
+var shellVendors = map[opb.Device_Vendor]*shellVendor{
+	opb.Device_ARISTA: {
+		prompt:    regexp.MustCompile(`^[\w.\-]+(\([\w.\-]+\))?[>#] ?$`),
+		errors:    regexp.MustCompile(`^% ?(?i:invalid|incomplete|ambiguous|error|failed|unrecognized|not supported)`),
+		enable:    "enable",
+		init:      []string{"terminal length 0", "terminal width 32767"},
+		configure: "configure session",
+		commit:    []string{"commit"},
+		abort:     []string{"abort"},
+	},
+	opb.Device_CISCO: {
+		prompt:    regexp.MustCompile(`^(RP/[\w/]+:)?[\w.\-]+(\([\w.\-]+\))?[>#] ?$`),
+		errors:    regexp.MustCompile(`^% ?(?i:invalid|incomplete|ambiguous|error|failed)`),
+		enable:    "enable",
+		init:      []string{"terminal length 0", "terminal width 0"},
+		configure: "configure terminal",
+		commit:    []string{"commit", "end"},
+		abort:     []string{"abort"},
+	},
+	opb.Device_JUNIPER: {
+		prompt:    regexp.MustCompile(`^[\w.\-]+@[\w.\-]+[>#%] ?$`),
+		noise:     regexp.MustCompile(`^(\[edit.*\]|\{\w+(:\d+)?\})$`),
+		errors:    regexp.MustCompile(`^(error:|syntax error|unknown command|missing argument|invalid value)`),
+		init:      []string{"set cli screen-length 0", "set cli screen-width 0"},
+		configure: "configure private",
+		commit:    []string{"commit and-quit"},
+		abort:     []string{"rollback 0", "exit"},
+	},
+	opb.Device_NOKIA: {
+		prompt:    regexp.MustCompile(`^[*+-]*[A-Z]?:[\w.\-@]+# ?$`),
+		noise:     regexp.MustCompile(`^-+\{.*\}-+(\[.*\]-+)?$`),
+		errors:    regexp.MustCompile(`^(Error|Parsing error|Syntax error|Minor|Major|Critical):`),
+		configure: "enter candidate private",
+		commit:    []string{"commit now"},
+		abort:     []string{"discard now"},
+	},
+}
+
+var (
+	// pagerRE matches the pagination prompts of the vendors, e.g. "--More--" and
+	// "---(more 45%)---", which are answered by a space.
+	pagerRE = regexp.MustCompile(`(?i)-+ ?\(?more[^)\-]*\)? ?-+`)
+	// escapeRE matches the terminal escape sequences in the output.
+	escapeRE = regexp.MustCompile(`\x1b\[[0-9;?]*[A-Za-z]`)
+)
+
+// shell runs commands in an interactive shell on a device, one at a time,
+// detecting the end of their output by the prompt of the vendor.
+type shell struct {
+	vendor *shellVendor
+	sess   *ssh.Session
+	stdin  io.Writer
+	out    chan []byte   // Output of the shell, closed when the shell exits.
+	done   chan struct{} // Closed when the shell is closed.
+
+	closeOnce sync.Once
+}
+
+// newShell starts an interactive shell on the device, enters privileged mode
+// if needed, and runs the initial commands of the vendor.
+func newShell(ctx context.Context, sc *ssh.Client, vendor *shellVendor) (_ *shell, rerr error) {
+	sess, err := sc.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("could not create session: %w", err)
+	}
+	defer func() {
+		if rerr != nil {
+			sess.Close()
+		}
+	}()
+	if err := sess.RequestPty("vt100", 0, 32767, ssh.TerminalModes{}); err != nil {
+		return nil, fmt.Errorf("could not request pty: %w", err)
+	}
+	stdin, err := sess.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := sess.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := sess.Shell(); err != nil {
+		return nil, fmt.Errorf("could not start shell: %w", err)
+	}
+	s := &shell{vendor: vendor, sess: sess, stdin: stdin, out: make(chan []byte), done: make(chan struct{})}
+	go s.read(stdout)
+	defer func() {
+		if rerr != nil {
+			s.close()
+		}
+	}()
+
+	_, prompt, err := s.readUntilPrompt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var cmds []string
+	if vendor.enable != "" && strings.HasSuffix(strings.TrimSpace(prompt), ">") {
+		cmds = append(cmds, vendor.enable)
+	}
+	for _, cmd := range append(cmds, vendor.init...) {
+		res, err := s.run(ctx, cmd)
+		if err != nil {
+			return nil, err
+		}
+		if res.error != "" {
+			return nil, fmt.Errorf("command %q failed: %s", cmd, res.error)
+		}
+	}
+	return s, nil
+}
+
+// read sends the output of the shell to the out channel until the shell exits
+// or is closed.
+func (s *shell) read(r io.Reader) {
+	defer close(s.out)
+	for {
+		buf := make([]byte, 4096)
+		n, err := r.Read(buf)
+		if n > 0 {
+			select {
+			case s.out <- buf[:n]:
+			case <-s.done:
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// close closes the shell and stops reading its output.
+func (s *shell) close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		err = s.sess.Close()
+	})
+	return err
+}
+
+// readUntilPrompt reads the output of the shell until it shows a prompt,
+// answering the pagination prompts, and returns the output before the prompt
+// and the prompt.
+func (s *shell) readUntilPrompt(ctx context.Context) (string, string, error) {
+	var out strings.Builder
+	timer := time.NewTimer(shellIdleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return "", "", ctx.Err()
+		case <-timer.C:
+			return "", "", fmt.Errorf("no prompt after %v of silence; last output %q", shellIdleTimeout, lastLine(out.String()))
+		case data, ok := <-s.out:
+			if !ok {
+				return "", "", errors.New("shell exited")
+			}
+			out.Write(data)
+			timer.Reset(shellIdleTimeout)
+		}
+		last := lastLine(out.String())
+		if pagerRE.MatchString(last) {
+			if _, err := io.WriteString(s.stdin, " "); err != nil {
+				return "", "", err
+			}
+			continue
+		}
+		if s.vendor.prompt.MatchString(last) {
+			all := out.String()
+			return all[:strings.LastIndex(all, "\n")+1], last, nil
+		}
+	}
+}
+
+// lastLine returns the last, unterminated line of the output as displayed.
+func lastLine(out string) string {
+	return displayed(out[strings.LastIndex(out, "\n")+1:])
+}
+
+// displayed returns a line of output as a terminal displays it, without the
+// escape sequences, and with the text after a carriage return written over the
+// start of the line.
+func displayed(line string) string {
+	line = escapeRE.ReplaceAllString(line, "")
+	line = strings.TrimRight(line, "\r")
+	var shown []rune
+	for _, part := range strings.Split(line, "\r") {
+		r := []rune(part)
+		if len(r) >= len(shown) {
+			shown = r
+			continue
+		}
+		copy(shown, r)
+	}
+	return string(shown)
+}
+
+// run runs the command and returns its output, with the error reported in the
+// output, if any.
+func (s *shell) run(ctx context.Context, cmd string) (*cmdResult, error) {
+	if _, err := io.WriteString(s.stdin, cmd+"\n"); err != nil {
+		return nil, err
+	}
+	raw, _, err := s.readUntilPrompt(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("command %q: %w", cmd, err)
+	}
+	res := new(cmdResult)
+	var lines []string
+	for i, line := range strings.SplitAfter(raw, "\n") {
+		if line == "" {
+			break // After the last newline.
+		}
+		line = strings.TrimRight(pagerRE.ReplaceAllString(displayed(strings.TrimSuffix(line, "\n")), ""), " ")
+		switch {
+		case i == 0 && strings.HasSuffix(strings.TrimSpace(line), cmd):
+			continue // Echo of the command.
+		case s.vendor.noise != nil && s.vendor.noise.MatchString(strings.TrimSpace(line)):
+			continue
+		case res.error == "" && s.vendor.errors.MatchString(strings.TrimSpace(line)):
+			res.error = strings.TrimSpace(line)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > 0 {
+		res.output = strings.Join(lines, "\n") + "\n"
+	}
+	return res, nil
+}
+
+// configure enters configuration mode, runs each line of the config, and
+// commits it.  If a line or the commit fails, the config is discarded.  It
+// returns the result of each line of the config that was run.
+func (s *shell) configure(ctx context.Context, config string) ([]*cmdResult, error) {
+	res, err := s.run(ctx, s.vendor.configure)
+	if err != nil {
+		return nil, err
+	}
+	if res.error != "" {
+		return nil, fmt.Errorf("could not enter configuration mode: %s", res.error)
+	}
+	var results []*cmdResult
+	for i, line := range strings.Split(config, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		res, err := s.run(ctx, line)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, res)
+		if res.error != "" {
+			return results, errors.Join(fmt.Errorf("config line %d %q: %s", i+1, line, res.error), s.abort(ctx))
+		}
+	}
+	for _, cmd := range s.vendor.commit {
+		res, err := s.run(ctx, cmd)
+		if err != nil {
+			return results, err
+		}
+		if res.error != "" {
+			return results, errors.Join(fmt.Errorf("could not commit config: %s", res.error), s.abort(ctx))
+		}
+	}
+	return results, nil
+}
+
+// abort discards the config and leaves configuration mode.
+func (s *shell) abort(ctx context.Context) error {
+	for _, cmd := range s.vendor.abort {
+		res, err := s.run(ctx, cmd)
+		if err != nil {
+			return err
+		}
+		if res.error != "" {
+			return fmt.Errorf("could not discard config: %s", res.error)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binding
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+
+	bindpb "github.com/openconfig/featureprofiles/topologies/proto/binding"
+	"github.com/openconfig/ondatra/binding/introspect"
+	opb "github.com/openconfig/ondatra/proto"
+	"github.com/openconfig/ygot/ygot"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// ReadBinding reads a binding configuration file.
+func ReadBinding(bindingFile string) (*bindpb.Binding, error) {
+	in, err := os.ReadFile(bindingFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read binding file: %w", err)
+	}
+	b := &bindpb.Binding{}
+	if err := prototext.Unmarshal(in, b); err != nil {
+		return nil, fmt.Errorf("unable to parse binding file: %w", err)
+	}
+	return b, nil
+}
+
+// Validate checks the binding without a testbed, the way a static binding
+// would use it during a reservation, and returns every problem found.  It
+// checks that device and port IDs are unique, that every service resolves to a
+// target and a valid port, that the hosts, TLS and key files named in the
+// options exist and parse, that the credential sources can be read, that the
+// proxies and jump hosts are valid, and that the reset config and action files
+// exist and parse.
+func Validate(b *bindpb.Binding) []error {
+	var errs []error
+	r := resolver{b}
+
+	errs = append(errs, checkOptions("binding options", b.GetOptions())...)
+	errs = append(errs, checkDevices("DUT", b.GetDuts())...)
+	errs = append(errs, checkDevices("ATE", b.GetAtes())...)
+
+	for _, dut := range b.GetDuts() {
+		for _, svc := range sortedServices(dutSvcParams) {
+			errs = append(errs, checkResolved(fmt.Sprintf("DUT %q %v", dut.GetId(), svc), r.grpc(dut, dutSvcParams[svc]))...)
+		}
+		errs = append(errs, checkResolved(fmt.Sprintf("DUT %q SSH", dut.GetId()), r.ssh(dut))...)
+		errs = append(errs, checkConfigs(dut)...)
+	}
+	for _, ate := range b.GetAtes() {
+		if ate.GetOtg() != nil && ate.GetIxnetwork() != nil {
+			errs = append(errs, fmt.Errorf("otg and ixnetwork are mutually exclusive, please configure one of them in ate %s binding", ate.GetName()))
+		}
+		for _, svc := range sortedServices(ateSvcParams) {
+			errs = append(errs, checkResolved(fmt.Sprintf("ATE %q %v", ate.GetId(), svc), r.grpc(ate, ateSvcParams[svc]))...)
+		}
+		if ate.GetIxnetwork() != nil {
+			errs = append(errs, checkResolved(fmt.Sprintf("ATE %q IxNetwork", ate.GetId()), r.ixnetwork(ate))...)
+		}
+	}
+
+	if b.GetDynamic() {
+		if _, _, _, err := protoToConcreteGraph(b); err != nil {
+			errs = append(errs, fmt.Errorf("invalid dynamic binding: %w", err))
+		}
+	}
+	return errs
+}
+
+// ValidateTestbed checks that the binding can reserve the testbed, using the
+// same resolution as a reservation, and returns every problem found.
+func ValidateTestbed(ctx context.Context, b *bindpb.Binding, tb *opb.Testbed) []error {
+	r := resolver{b}
+	if r.Dynamic {
+		if _, err := dynamicReservation(ctx, tb, r, nil); err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+	_, errs := staticReservation(tb, r)
+	return errs
+}
+
+func sortedServices(params map[introspect.Service]*svcParams) []introspect.Service {
+	var svcs []introspect.Service
+	for svc := range params {
+		svcs = append(svcs, svc)
+	}
+	sort.Slice(svcs, func(i, j int) bool { return svcs[i] < svcs[j] })
+	return svcs
+}
+
+// checkDevices checks the IDs, names and port IDs of the devices, and the files
+// named in their options.
+func checkDevices(kind string, devs []*bindpb.Device) []error {
+	var errs []error
+	ids := make(map[string]bool)
+	for _, dev := range devs {
+		id := dev.GetId()
+		switch {
+		case id == "":
+			errs = append(errs, fmt.Errorf("%s %q has no id", kind, dev.GetName()))
+		case ids[id]:
+			errs = append(errs, fmt.Errorf("duplicate binding for %s %q", kind, id))
+		}
+		ids[id] = true
+
+		ports := make(map[string]bool)
+		for _, port := range dev.GetPorts() {
+			switch {
+			case port.GetId() == "":
+				errs = append(errs, fmt.Errorf("port %q on %q has no id", port.GetName(), id))
+			case ports[port.GetId()]:
+				errs = append(errs, fmt.Errorf("duplicate binding for port %q on %q", port.GetId(), id))
+			case port.GetName() == "":
+				errs = append(errs, fmt.Errorf("port %q on %q has no name", port.GetId(), id))
+			}
+			ports[port.GetId()] = true
+		}
+
+		for _, o := range []struct {
+			name string
+			opts *bindpb.Options
+		}{
+			{"options", dev.GetOptions()},
+			{"ssh", dev.GetSsh()},
+			{"gnmi", dev.GetGnmi()},
+			{"gnoi", dev.GetGnoi()},
+			{"gnsi", dev.GetGnsi()},
+			{"gribi", dev.GetGribi()},
+			{"p4rt", dev.GetP4Rt()},
+			{"ixnetwork", dev.GetIxnetwork()},
+			{"otg", dev.GetOtg()},
+		} {
+			errs = append(errs, checkOptions(fmt.Sprintf("%s %q %s", kind, id, o.name), o.opts)...)
+		}
+	}
+	return errs
+}
+
+// checkOptions checks that the hosts, TLS and jump host key files named by the
+// options exist and parse, that the credential sources can be read, and that
+// the proxy and jump hosts are valid, whether or not they are used.
+func checkOptions(where string, bopts *bindpb.Options) []error {
+	var errs []error
+	if p := bopts.GetProxy(); p != "" {
+		if _, err := parseProxy(p); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", where, err))
+		}
+	}
+	if s := bopts.GetUsernameFrom(); s != nil {
+		if err := checkSecret(s); err != nil {
+			errs = append(errs, fmt.Errorf("%s: username_from: %w", where, err))
+		}
+	}
+	if s := bopts.GetPasswordFrom(); s != nil {
+		if err := checkSecret(s); err != nil {
+			errs = append(errs, fmt.Errorf("%s: password_from: %w", where, err))
+		}
+	}
+	if t := bopts.GetToken(); t != nil {
+		if err := checkSecret(t.GetSource()); err != nil {
+			errs = append(errs, fmt.Errorf("%s: token: %w", where, err))
+		}
+		if t.GetLifetime() < 0 || t.GetRefresh() < 0 {
+			errs = append(errs, fmt.Errorf("%s: token has a negative lifetime or refresh", where))
+		}
+	}
+	for i, jh := range bopts.GetJumpHosts() {
+		if jh.GetTarget() == "" {
+			errs = append(errs, fmt.Errorf("%s: jump host %d has no target", where, i+1))
+		}
+		if s := jh.GetPasswordFrom(); s != nil {
+			if err := checkSecret(s); err != nil {
+				errs = append(errs, fmt.Errorf("%s: jump host %d password_from: %w", where, i+1, err))
+			}
+		}
+		if file := jh.GetKeyFile(); file != "" {
+			if data, err := os.ReadFile(file); err != nil {
+				errs = append(errs, fmt.Errorf("%s: jump host %d key_file: %w", where, i+1, err))
+			} else if _, err := ssh.ParsePrivateKey(data); err != nil {
+				errs = append(errs, fmt.Errorf("%s: jump host %d key_file %s: %w", where, i+1, file, err))
+			}
+		}
+	}
+	if file := bopts.GetTrustBundleFile(); file != "" {
+		if data, err := os.ReadFile(file); err != nil {
+			errs = append(errs, fmt.Errorf("%s: trust_bundle_file: %w", where, err))
+		} else if !x509.NewCertPool().AppendCertsFromPEM(data) {
+			errs = append(errs, fmt.Errorf("%s: trust_bundle_file %s has no PEM certificates", where, file))
+		}
+	}
+	if file := bopts.GetHostsFile(); file != "" {
+		if _, err := lookupHostsFile(file, ""); err != nil {
+			errs = append(errs, fmt.Errorf("%s: hosts_file: %w", where, err))
+		}
+	}
+	certFile, keyFile := bopts.GetCertFile(), bopts.GetKeyFile()
+	switch {
+	case certFile != "" && keyFile != "":
+		if _, err := tls.LoadX509KeyPair(certFile, keyFile); err != nil {
+			errs = append(errs, fmt.Errorf("%s: cert_file and key_file: %w", where, err))
+		}
+	case certFile != "":
+		if _, err := os.Stat(certFile); err != nil {
+			errs = append(errs, fmt.Errorf("%s: cert_file: %w", where, err))
+		}
+	case keyFile != "":
+		if _, err := os.Stat(keyFile); err != nil {
+			errs = append(errs, fmt.Errorf("%s: key_file: %w", where, err))
+		}
+	}
+	return errs
+}
+
+// checkSecret checks that the source of a credential can be read, without
+// running its command.
+func checkSecret(s *bindpb.Secret) error {
+	switch src := s.GetSource().(type) {
+	case *bindpb.Secret_Env:
+		if _, ok := os.LookupEnv(src.Env); !ok {
+			return fmt.Errorf("environment variable %s is not set", src.Env)
+		}
+	case *bindpb.Secret_File:
+		if _, err := os.Stat(src.File); err != nil {
+			return err
+		}
+	case *bindpb.Secret_Command:
+		args := src.Command.GetArgs()
+		if len(args) == 0 {
+			return fmt.Errorf("command has no args")
+		}
+		if _, err := exec.LookPath(args[0]); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("no source")
+	}
+	return nil
+}
+
+// checkResolved checks the options that a service of a device resolves to.  The
+// files themselves are checked by checkOptions where they are named.
+func checkResolved(where string, bopts *bindpb.Options) []error {
+	var errs []error
+	if bopts.GetTarget() == "" {
+		errs = append(errs, fmt.Errorf("%s: no target; set the device name or options target", where))
+	}
+	if port := bopts.GetPort(); port < 0 || port > 65535 {
+		errs = append(errs, fmt.Errorf("%s: port %d is out of range", where, port))
+	}
+	// As in dialOpts, insecure and skip_verify take precedence over mutual_tls.
+	if bopts.GetMutualTls() && !bopts.GetInsecure() && !bopts.GetSkipVerify() {
+		if bopts.GetCertFile() == "" || bopts.GetKeyFile() == "" || bopts.GetTrustBundleFile() == "" {
+			errs = append(errs, fmt.Errorf("%s: cert_file, key_file, and trust_bundle_file need to be set when mutual tls is set", where))
+		}
+	}
+	return errs
+}
+
+// checkConfigs checks that the reset config files of the DUT exist and parse.
+func checkConfigs(dut *bindpb.Device) []error {
+	var errs []error
+	for _, file := range dut.GetConfig().GetCliFile() {
+		if _, err := readCLI(file); err != nil {
+			errs = append(errs, fmt.Errorf("DUT %q cli_file: %w", dut.GetId(), err))
+		}
+	}
+	for _, file := range dut.GetConfig().GetGnmiSetFile() {
+		if _, err := readGNMI(file); err != nil {
+			errs = append(errs, fmt.Errorf("DUT %q gnmi_set_file %s: %w", dut.GetId(), file, err))
+		}
+	}
+	for i, action := range dut.GetConfig().GetActions() {
+		if err := checkAction(action); err != nil {
+			errs = append(errs, fmt.Errorf("DUT %q reset action %d: %w", dut.GetId(), i+1, err))
+		}
+	}
+	return errs
+}
+
+// checkAction checks that the files of a reset action exist and parse.
+func checkAction(action *bindpb.ResetAction) error {
+	if action.GetTimeout() < 0 {
+		return fmt.Errorf("negative timeout %d", action.GetTimeout())
+	}
+	switch a := action.GetAction().(type) {
+	case *bindpb.ResetAction_GnmiReplaceFile:
+		_, err := readJSON(a.GnmiReplaceFile)
+		return err
+	case *bindpb.ResetAction_GnoiFilePut:
+		if a.GnoiFilePut.GetRemoteFile() == "" {
+			return fmt.Errorf("gnoi_file_put has no remote_file")
+		}
+		_, err := os.Stat(a.GnoiFilePut.GetLocalFile())
+		return err
+	case *bindpb.ResetAction_GnsiAuthzFile:
+		_, err := readJSON(a.GnsiAuthzFile)
+		return err
+	case *bindpb.ResetAction_GnsiCertz:
+		if _, err := tls.LoadX509KeyPair(a.GnsiCertz.GetCertFile(), a.GnsiCertz.GetKeyFile()); err != nil {
+			return err
+		}
+		data, err := os.ReadFile(a.GnsiCertz.GetTrustBundleFile())
+		if err != nil {
+			return err
+		}
+		if !x509.NewCertPool().AppendCertsFromPEM(data) {
+			return fmt.Errorf("trust_bundle_file %s has no PEM certificates", a.GnsiCertz.GetTrustBundleFile())
+		}
+	case *bindpb.ResetAction_Verify:
+		for path := range a.Verify.GetGnmiState() {
+			if _, err := ygot.StringToStructuredPath(path); err != nil {
+				return fmt.Errorf("invalid gnmi_state path %q: %w", path, err)
+			}
+		}
+	case nil:
+		return fmt.Errorf("no action")
+	}
+	return nil
+}
@@ -120,15 +120,25 @@ func (x *Binding) GetLinks() []*Link {
 // Config for resetting the device before the test run.
 type Configs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Raw device config
+	// Raw device config, sent as-is unless cli_config_mode is set.
 	Cli [][]byte `protobuf:"bytes,1,rep,name=cli,proto3" json:"cli,omitempty"`
-	// Path to file containing raw device config
+	// Path to file containing raw device config, sent as-is unless
+	// cli_config_mode is set.
 	CliFile []string `protobuf:"bytes,2,rep,name=cli_file,json=cliFile,proto3" json:"cli_file,omitempty"`
 	// Path to a file containing gNMI SetRequest as text-formatted proto.
 	GnmiSetFile []string `protobuf:"bytes,3,rep,name=gnmi_set_file,json=gnmiSetFile,proto3" json:"gnmi_set_file,omitempty"`
 	// Whether to flush gRIBI.  If true, this will send a FlushRequest for all
 	// network instances and overriding the election ID.
-	GribiFlush    bool `protobuf:"varint,4,opt,name=gribi_flush,json=gribiFlush,proto3" json:"gribi_flush,omitempty"`
+	GribiFlush bool `protobuf:"varint,4,opt,name=gribi_flush,json=gribiFlush,proto3" json:"gribi_flush,omitempty"`
+	// Reset actions to run in order, after the above.
+	Actions []*ResetAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Whether to send cli and cli_file one line at a time in the config mode of
+	// the vendor, which is entered with "configure session" on Arista,
+	// "configure terminal" on Cisco, "configure private" on Juniper and "enter
+	// candidate private" on Nokia, and committed after the last line.  The
+	// config must then not enter or leave the config mode itself.  Other vendors
+	// do not support the config mode.
+	CliConfigMode bool `protobuf:"varint,6,opt,name=cli_config_mode,json=cliConfigMode,proto3" json:"cli_config_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -191,6 +201,497 @@ func (x *Configs) GetGribiFlush() bool {
 	return false
 }
 
+func (x *Configs) GetActions() []*ResetAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *Configs) GetCliConfigMode() bool {
+	if x != nil {
+		return x.CliConfigMode
+	}
+	return false
+}
+
+// A reset action.
+type ResetAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time in seconds for the action to complete, after which the reset fails.
+	// If not set, it is the -reset-action-timeout flag.
+	Timeout int32 `protobuf:"varint,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*ResetAction_GnmiReplaceFile
+	//	*ResetAction_GnoiFilePut
+	//	*ResetAction_GnoiReboot
+	//	*ResetAction_P4RtClear
+	//	*ResetAction_GnsiAuthzFile
+	//	*ResetAction_GnsiCertz
+	//	*ResetAction_Verify
+	Action        isResetAction_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetAction) Reset() {
+	*x = ResetAction{}
+	mi := &file_binding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetAction) ProtoMessage() {}
+
+func (x *ResetAction) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetAction.ProtoReflect.Descriptor instead.
+func (*ResetAction) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResetAction) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *ResetAction) GetAction() isResetAction_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ResetAction) GetGnmiReplaceFile() string {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_GnmiReplaceFile); ok {
+			return x.GnmiReplaceFile
+		}
+	}
+	return ""
+}
+
+func (x *ResetAction) GetGnoiFilePut() *GNOIFilePut {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_GnoiFilePut); ok {
+			return x.GnoiFilePut
+		}
+	}
+	return nil
+}
+
+func (x *ResetAction) GetGnoiReboot() *GNOIReboot {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_GnoiReboot); ok {
+			return x.GnoiReboot
+		}
+	}
+	return nil
+}
+
+func (x *ResetAction) GetP4RtClear() *P4RTClear {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_P4RtClear); ok {
+			return x.P4RtClear
+		}
+	}
+	return nil
+}
+
+func (x *ResetAction) GetGnsiAuthzFile() string {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_GnsiAuthzFile); ok {
+			return x.GnsiAuthzFile
+		}
+	}
+	return ""
+}
+
+func (x *ResetAction) GetGnsiCertz() *GNSICertz {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_GnsiCertz); ok {
+			return x.GnsiCertz
+		}
+	}
+	return nil
+}
+
+func (x *ResetAction) GetVerify() *ResetVerify {
+	if x != nil {
+		if x, ok := x.Action.(*ResetAction_Verify); ok {
+			return x.Verify
+		}
+	}
+	return nil
+}
+
+type isResetAction_Action interface {
+	isResetAction_Action()
+}
+
+type ResetAction_GnmiReplaceFile struct {
+	// Path to a file containing the full device config as OpenConfig JSON
+	// (RFC 7951), which replaces the config at the root through gNMI.
+	GnmiReplaceFile string `protobuf:"bytes,2,opt,name=gnmi_replace_file,json=gnmiReplaceFile,proto3,oneof"`
+}
+
+type ResetAction_GnoiFilePut struct {
+	// Copy a file to the device through gNOI.
+	GnoiFilePut *GNOIFilePut `protobuf:"bytes,3,opt,name=gnoi_file_put,json=gnoiFilePut,proto3,oneof"`
+}
+
+type ResetAction_GnoiReboot struct {
+	// Reboot the device through gNOI and wait for it to come back.
+	GnoiReboot *GNOIReboot `protobuf:"bytes,4,opt,name=gnoi_reboot,json=gnoiReboot,proto3,oneof"`
+}
+
+type ResetAction_P4RtClear struct {
+	// Delete all the P4RT table entries.
+	P4RtClear *P4RTClear `protobuf:"bytes,5,opt,name=p4rt_clear,json=p4rtClear,proto3,oneof"`
+}
+
+type ResetAction_GnsiAuthzFile struct {
+	// Path to a file containing a gNSI authz policy in JSON, which is rotated
+	// onto the device.
+	GnsiAuthzFile string `protobuf:"bytes,6,opt,name=gnsi_authz_file,json=gnsiAuthzFile,proto3,oneof"`
+}
+
+type ResetAction_GnsiCertz struct {
+	// Rotate a certificate and trust bundle onto the device through gNSI.
+	GnsiCertz *GNSICertz `protobuf:"bytes,7,opt,name=gnsi_certz,json=gnsiCertz,proto3,oneof"`
+}
+
+type ResetAction_Verify struct {
+	// Wait for the device to reach a healthy state.
+	Verify *ResetVerify `protobuf:"bytes,8,opt,name=verify,proto3,oneof"`
+}
+
+func (*ResetAction_GnmiReplaceFile) isResetAction_Action() {}
+
+func (*ResetAction_GnoiFilePut) isResetAction_Action() {}
+
+func (*ResetAction_GnoiReboot) isResetAction_Action() {}
+
+func (*ResetAction_P4RtClear) isResetAction_Action() {}
+
+func (*ResetAction_GnsiAuthzFile) isResetAction_Action() {}
+
+func (*ResetAction_GnsiCertz) isResetAction_Action() {}
+
+func (*ResetAction_Verify) isResetAction_Action() {}
+
+// Copies a file to the device through gNOI.
+type GNOIFilePut struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path to the file to copy.
+	LocalFile string `protobuf:"bytes,1,opt,name=local_file,json=localFile,proto3" json:"local_file,omitempty"`
+	// Path of the file on the device.
+	RemoteFile string `protobuf:"bytes,2,opt,name=remote_file,json=remoteFile,proto3" json:"remote_file,omitempty"`
+	// Permissions of the file on the device, e.g. 0644, which is the default.
+	Permissions   uint32 `protobuf:"varint,3,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GNOIFilePut) Reset() {
+	*x = GNOIFilePut{}
+	mi := &file_binding_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GNOIFilePut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GNOIFilePut) ProtoMessage() {}
+
+func (x *GNOIFilePut) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GNOIFilePut.ProtoReflect.Descriptor instead.
+func (*GNOIFilePut) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GNOIFilePut) GetLocalFile() string {
+	if x != nil {
+		return x.LocalFile
+	}
+	return ""
+}
+
+func (x *GNOIFilePut) GetRemoteFile() string {
+	if x != nil {
+		return x.RemoteFile
+	}
+	return ""
+}
+
+func (x *GNOIFilePut) GetPermissions() uint32 {
+	if x != nil {
+		return x.Permissions
+	}
+	return 0
+}
+
+// Reboots the device through gNOI.
+type GNOIReboot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Message for the reboot.
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GNOIReboot) Reset() {
+	*x = GNOIReboot{}
+	mi := &file_binding_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GNOIReboot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GNOIReboot) ProtoMessage() {}
+
+func (x *GNOIReboot) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GNOIReboot.ProtoReflect.Descriptor instead.
+func (*GNOIReboot) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GNOIReboot) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Deletes all the P4RT table entries, as the primary controller.
+type P4RTClear struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// P4RT device ID.
+	DeviceId uint64 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Election ID of the controller; if not set, it is 1.
+	ElectionId    uint64 `protobuf:"varint,2,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *P4RTClear) Reset() {
+	*x = P4RTClear{}
+	mi := &file_binding_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *P4RTClear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*P4RTClear) ProtoMessage() {}
+
+func (x *P4RTClear) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use P4RTClear.ProtoReflect.Descriptor instead.
+func (*P4RTClear) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *P4RTClear) GetDeviceId() uint64 {
+	if x != nil {
+		return x.DeviceId
+	}
+	return 0
+}
+
+func (x *P4RTClear) GetElectionId() uint64 {
+	if x != nil {
+		return x.ElectionId
+	}
+	return 0
+}
+
+// Rotates a certificate and trust bundle through gNSI certz.
+type GNSICertz struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SSL profile of the certificate.
+	SslProfileId string `protobuf:"bytes,1,opt,name=ssl_profile_id,json=sslProfileId,proto3" json:"ssl_profile_id,omitempty"`
+	// Path to a *.pem file containing the certificate.
+	CertFile string `protobuf:"bytes,2,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	// Path to a *.pem file containing the private key of the certificate.
+	KeyFile string `protobuf:"bytes,3,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Path to a *.pem file containing the trust bundle.
+	TrustBundleFile string `protobuf:"bytes,4,opt,name=trust_bundle_file,json=trustBundleFile,proto3" json:"trust_bundle_file,omitempty"`
+	// Version of the certificate and trust bundle.
+	Version       string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GNSICertz) Reset() {
+	*x = GNSICertz{}
+	mi := &file_binding_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GNSICertz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GNSICertz) ProtoMessage() {}
+
+func (x *GNSICertz) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GNSICertz.ProtoReflect.Descriptor instead.
+func (*GNSICertz) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GNSICertz) GetSslProfileId() string {
+	if x != nil {
+		return x.SslProfileId
+	}
+	return ""
+}
+
+func (x *GNSICertz) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *GNSICertz) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *GNSICertz) GetTrustBundleFile() string {
+	if x != nil {
+		return x.TrustBundleFile
+	}
+	return ""
+}
+
+func (x *GNSICertz) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Checks that the device is healthy, retrying until the checks pass or the
+// action times out.  gNMI must respond in any case.
+type ResetVerify struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Values that state paths must have, keyed by path, e.g.
+	// "/system/state/hostname": "dut1".
+	GnmiState     map[string]string `protobuf:"bytes,1,rep,name=gnmi_state,json=gnmiState,proto3" json:"gnmi_state,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetVerify) Reset() {
+	*x = ResetVerify{}
+	mi := &file_binding_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetVerify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetVerify) ProtoMessage() {}
+
+func (x *ResetVerify) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetVerify.ProtoReflect.Descriptor instead.
+func (*ResetVerify) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetVerify) GetGnmiState() map[string]string {
+	if x != nil {
+		return x.GnmiState
+	}
+	return nil
+}
+
 // A device binding.
 type Device struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -228,13 +729,16 @@ type Device struct {
 	HardwareModel string `protobuf:"bytes,20,opt,name=hardware_model,json=hardwareModel,proto3" json:"hardware_model,omitempty"`
 	// Software version of the device.
 	SoftwareVersion string `protobuf:"bytes,21,opt,name=software_version,json=softwareVersion,proto3" json:"software_version,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Management address of the device: a hostname, or an IPv4 or IPv6 address,
+	// with or without brackets.  If set, it is dialed instead of the name.
+	Address       string `protobuf:"bytes,22,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Device) Reset() {
 	*x = Device{}
-	mi := &file_binding_proto_msgTypes[2]
+	mi := &file_binding_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,185 +747,500 @@ func (x *Device) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Device) ProtoMessage() {}
-
-func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[2]
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Device) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Device) GetConfig() *Configs {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Device) GetSsh() *Options {
+	if x != nil {
+		return x.Ssh
+	}
+	return nil
+}
+
+func (x *Device) GetGnmi() *Options {
+	if x != nil {
+		return x.Gnmi
+	}
+	return nil
+}
+
+func (x *Device) GetGnoi() *Options {
+	if x != nil {
+		return x.Gnoi
+	}
+	return nil
+}
+
+func (x *Device) GetGnsi() *Options {
+	if x != nil {
+		return x.Gnsi
+	}
+	return nil
+}
+
+func (x *Device) GetGribi() *Options {
+	if x != nil {
+		return x.Gribi
+	}
+	return nil
+}
+
+func (x *Device) GetP4Rt() *Options {
+	if x != nil {
+		return x.P4Rt
+	}
+	return nil
+}
+
+func (x *Device) GetIxnetwork() *Options {
+	if x != nil {
+		return x.Ixnetwork
+	}
+	return nil
+}
+
+func (x *Device) GetOtg() *Options {
+	if x != nil {
+		return x.Otg
+	}
+	return nil
+}
+
+func (x *Device) GetVendor() proto.Device_Vendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return proto.Device_Vendor(0)
+}
+
+func (x *Device) GetHardwareModel() string {
+	if x != nil {
+		return x.HardwareModel
+	}
+	return ""
+}
+
+func (x *Device) GetSoftwareVersion() string {
+	if x != nil {
+		return x.SoftwareVersion
+	}
+	return ""
+}
+
+func (x *Device) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// Dial options.
+type Options struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is the dial target, typically formatted as "hostname:port".
+	// If not set, it will use the device address or name and the port.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Use plain HTTP/2 and omit TLS (gRPC only).
+	Insecure bool `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// When using TLS, skip certificate verification (gRPC and HTTP).
+	SkipVerify bool `protobuf:"varint,3,opt,name=skip_verify,json=skipVerify,proto3" json:"skip_verify,omitempty"`
+	// The username for authentication.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// The password for authentication.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// The session_id for ATE REST API session id
+	SessionId int32 `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// gRPC request timeout (second)
+	Timeout int32 `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// gRPC dial option to set the maximum recv message size in bytes.
+	MaxRecvMsgSize int32 `protobuf:"varint,8,opt,name=max_recv_msg_size,json=maxRecvMsgSize,proto3" json:"max_recv_msg_size,omitempty"`
+	// When using TLS, enable mutual certificate verification (gRPC)
+	MutualTls bool `protobuf:"varint,9,opt,name=mutual_tls,json=mutualTls,proto3" json:"mutual_tls,omitempty"`
+	// Trust bundle file: a *.pem file that contains one or more certificates (root and intermediate CAs)
+	TrustBundleFile string `protobuf:"bytes,10,opt,name=trust_bundle_file,json=trustBundleFile,proto3" json:"trust_bundle_file,omitempty"`
+	// Certificate file path : a *.pem file that is signed by root or intermediate CA
+	CertFile string `protobuf:"bytes,11,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	// Key file Path: a *.pem file that contains a private key
+	KeyFile string `protobuf:"bytes,12,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// The port to dial if the target is not set (gRPC and SSH).  If not set,
+	// it is the default port for the protocol.
+	Port int32 `protobuf:"varint,13,opt,name=port,proto3" json:"port,omitempty"`
+	// Hosts file: a file in the /etc/hosts format that maps the device address
+	// or name to the IP address to dial, for devices that are not in DNS.
+	HostsFile string `protobuf:"bytes,14,opt,name=hosts_file,json=hostsFile,proto3" json:"hosts_file,omitempty"`
+	// Look up the host and port to dial in the DNS SRV record
+	// "_<service>._tcp.<host>" of the target host (gRPC and SSH), where the
+	// service is one of "gnmi", "gnoi", "gnsi", "gribi", "p4rt", "otg" or "ssh".
+	Srv bool `protobuf:"varint,15,opt,name=srv,proto3" json:"srv,omitempty"`
+	// SSH jump hosts to tunnel the connections to the device through, in order
+	// (gRPC and SSH).  If set, the jump hosts replace those of the options with
+	// lower precedence, rather than adding to them.
+	JumpHosts []*JumpHost `protobuf:"bytes,16,rep,name=jump_hosts,json=jumpHosts,proto3" json:"jump_hosts,omitempty"`
+	// Proxy to connect through, to the first jump host if any, or else to the
+	// device (gRPC and SSH).  It is a URL of the form
+	// "socks5://[user:password@]host:port" for a SOCKS5 proxy, or
+	// "http://[user:password@]host:port" for an HTTP CONNECT proxy.
+	Proxy string `protobuf:"bytes,17,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// Where to read the username from, instead of the username field, so that it
+	// need not be in the binding file.
+	UsernameFrom *Secret `protobuf:"bytes,18,opt,name=username_from,json=usernameFrom,proto3" json:"username_from,omitempty"`
+	// Where to read the password from, instead of the password field, so that it
+	// need not be in the binding file.
+	PasswordFrom *Secret `protobuf:"bytes,19,opt,name=password_from,json=passwordFrom,proto3" json:"password_from,omitempty"`
+	// A bearer token to send in the "authorization" metadata of every call
+	// (gRPC only), in addition to the username and password, if any.
+	Token         *Token `protobuf:"bytes,20,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	mi := &file_binding_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Options) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Options) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Options) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
+func (x *Options) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Options) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Options) GetSessionId() int32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *Options) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Options) GetMaxRecvMsgSize() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxRecvMsgSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Device.ProtoReflect.Descriptor instead.
-func (*Device) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{2}
+func (x *Options) GetMutualTls() bool {
+	if x != nil {
+		return x.MutualTls
+	}
+	return false
 }
 
-func (x *Device) GetId() string {
+func (x *Options) GetTrustBundleFile() string {
 	if x != nil {
-		return x.Id
+		return x.TrustBundleFile
 	}
 	return ""
 }
 
-func (x *Device) GetName() string {
+func (x *Options) GetCertFile() string {
 	if x != nil {
-		return x.Name
+		return x.CertFile
 	}
 	return ""
 }
 
-func (x *Device) GetOptions() *Options {
+func (x *Options) GetKeyFile() string {
 	if x != nil {
-		return x.Options
+		return x.KeyFile
 	}
-	return nil
+	return ""
 }
 
-func (x *Device) GetPorts() []*Port {
+func (x *Options) GetPort() int32 {
 	if x != nil {
-		return x.Ports
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-func (x *Device) GetConfig() *Configs {
+func (x *Options) GetHostsFile() string {
 	if x != nil {
-		return x.Config
+		return x.HostsFile
 	}
-	return nil
+	return ""
 }
 
-func (x *Device) GetSsh() *Options {
+func (x *Options) GetSrv() bool {
 	if x != nil {
-		return x.Ssh
+		return x.Srv
 	}
-	return nil
+	return false
 }
 
-func (x *Device) GetGnmi() *Options {
+func (x *Options) GetJumpHosts() []*JumpHost {
 	if x != nil {
-		return x.Gnmi
+		return x.JumpHosts
 	}
 	return nil
 }
 
-func (x *Device) GetGnoi() *Options {
+func (x *Options) GetProxy() string {
 	if x != nil {
-		return x.Gnoi
+		return x.Proxy
 	}
-	return nil
+	return ""
 }
 
-func (x *Device) GetGnsi() *Options {
+func (x *Options) GetUsernameFrom() *Secret {
 	if x != nil {
-		return x.Gnsi
+		return x.UsernameFrom
 	}
 	return nil
 }
 
-func (x *Device) GetGribi() *Options {
+func (x *Options) GetPasswordFrom() *Secret {
 	if x != nil {
-		return x.Gribi
+		return x.PasswordFrom
 	}
 	return nil
 }
 
-func (x *Device) GetP4Rt() *Options {
+func (x *Options) GetToken() *Token {
 	if x != nil {
-		return x.P4Rt
+		return x.Token
 	}
 	return nil
 }
 
-func (x *Device) GetIxnetwork() *Options {
+// A source of a credential, read when the device is dialed.
+type Secret struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*Secret_Env
+	//	*Secret_File
+	//	*Secret_Command
+	Source        isSecret_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Secret) Reset() {
+	*x = Secret{}
+	mi := &file_binding_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret) ProtoMessage() {}
+
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[10]
 	if x != nil {
-		return x.Ixnetwork
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Device) GetOtg() *Options {
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Secret) GetSource() isSecret_Source {
 	if x != nil {
-		return x.Otg
+		return x.Source
 	}
 	return nil
 }
 
-func (x *Device) GetVendor() proto.Device_Vendor {
+func (x *Secret) GetEnv() string {
 	if x != nil {
-		return x.Vendor
+		if x, ok := x.Source.(*Secret_Env); ok {
+			return x.Env
+		}
 	}
-	return proto.Device_Vendor(0)
+	return ""
 }
 
-func (x *Device) GetHardwareModel() string {
+func (x *Secret) GetFile() string {
 	if x != nil {
-		return x.HardwareModel
+		if x, ok := x.Source.(*Secret_File); ok {
+			return x.File
+		}
 	}
 	return ""
 }
 
-func (x *Device) GetSoftwareVersion() string {
+func (x *Secret) GetCommand() *Command {
 	if x != nil {
-		return x.SoftwareVersion
+		if x, ok := x.Source.(*Secret_Command); ok {
+			return x.Command
+		}
 	}
-	return ""
+	return nil
 }
 
-// Dial options.
-type Options struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is the dial target, typically formatted as "hostname:port".
-	// If not set, it will use the device name and the default port for
-	// the protocol.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// Use plain HTTP/2 and omit TLS (gRPC only).
-	Insecure bool `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
-	// When using TLS, skip certificate verification (gRPC and HTTP).
-	SkipVerify bool `protobuf:"varint,3,opt,name=skip_verify,json=skipVerify,proto3" json:"skip_verify,omitempty"`
-	// The username for authentication.
-	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	// The password for authentication.
-	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
-	// The session_id for ATE REST API session id
-	SessionId int32 `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// gRPC request timeout (second)
-	Timeout int32 `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	// gRPC dial option to set the maximum recv message size in bytes.
-	MaxRecvMsgSize int32 `protobuf:"varint,8,opt,name=max_recv_msg_size,json=maxRecvMsgSize,proto3" json:"max_recv_msg_size,omitempty"`
-	// When using TLS, enable mutual certificate verification (gRPC)
-	MutualTls bool `protobuf:"varint,9,opt,name=mutual_tls,json=mutualTls,proto3" json:"mutual_tls,omitempty"`
-	// Trust bundle file: a *.pem file that contains one or more certificates (root and intermediate CAs)
-	TrustBundleFile string `protobuf:"bytes,10,opt,name=trust_bundle_file,json=trustBundleFile,proto3" json:"trust_bundle_file,omitempty"`
-	// Certificate file path : a *.pem file that is signed by root or intermediate CA
-	CertFile string `protobuf:"bytes,11,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
-	// Key file Path: a *.pem file that contains a private key
-	KeyFile       string `protobuf:"bytes,12,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+type isSecret_Source interface {
+	isSecret_Source()
+}
+
+type Secret_Env struct {
+	// The name of an environment variable.
+	Env string `protobuf:"bytes,1,opt,name=env,proto3,oneof"`
+}
+
+type Secret_File struct {
+	// The path to a file.  Leading and trailing white space is removed.
+	File string `protobuf:"bytes,2,opt,name=file,proto3,oneof"`
+}
+
+type Secret_Command struct {
+	// A command that prints the credential, e.g. a credential helper.  The
+	// first element is the program, and the rest are its arguments.  Leading
+	// and trailing white space is removed from its output.
+	Command *Command `protobuf:"bytes,3,opt,name=command,proto3,oneof"`
+}
+
+func (*Secret_Env) isSecret_Source() {}
+
+func (*Secret_File) isSecret_Source() {}
+
+func (*Secret_Command) isSecret_Source() {}
+
+type Command struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Args          []string               `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Options) Reset() {
-	*x = Options{}
-	mi := &file_binding_proto_msgTypes[3]
+func (x *Command) Reset() {
+	*x = Command{}
+	mi := &file_binding_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Options) String() string {
+func (x *Command) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Options) ProtoMessage() {}
+func (*Command) ProtoMessage() {}
 
-func (x *Options) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[3]
+func (x *Command) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,95 +1251,178 @@ func (x *Options) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Options.ProtoReflect.Descriptor instead.
-func (*Options) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Command.ProtoReflect.Descriptor instead.
+func (*Command) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Options) GetTarget() string {
+func (x *Command) GetArgs() []string {
 	if x != nil {
-		return x.Target
+		return x.Args
 	}
-	return ""
+	return nil
 }
 
-func (x *Options) GetInsecure() bool {
-	if x != nil {
-		return x.Insecure
-	}
-	return false
+// A bearer token that is read again before it expires.
+type Token struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The source of the token.  If it is a JSON object, the token is its "token"
+	// field, and it expires at the RFC 3339 time in its "expiry" field, if any.
+	Source *Secret `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// Time in seconds after which the token expires once read, if the source
+	// does not say.  If not set, the token does not expire.
+	Lifetime int32 `protobuf:"varint,2,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	// Time in seconds before the token expires to read it again.  If not set, it
+	// is 60 seconds, or half the lifetime of the token if shorter.
+	Refresh       int32 `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Options) GetSkipVerify() bool {
-	if x != nil {
-		return x.SkipVerify
-	}
-	return false
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_binding_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Options) GetUsername() string {
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[12]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Options) GetPassword() string {
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Token) GetSource() *Secret {
 	if x != nil {
-		return x.Password
+		return x.Source
 	}
-	return ""
+	return nil
 }
 
-func (x *Options) GetSessionId() int32 {
+func (x *Token) GetLifetime() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.Lifetime
 	}
 	return 0
 }
 
-func (x *Options) GetTimeout() int32 {
+func (x *Token) GetRefresh() int32 {
 	if x != nil {
-		return x.Timeout
+		return x.Refresh
 	}
 	return 0
 }
 
-func (x *Options) GetMaxRecvMsgSize() int32 {
+// An SSH server used as a jump host, e.g. a bastion.
+type JumpHost struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address of the jump host, formatted as "hostname:port".  If the port
+	// is not set, it is 22.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// The username for authentication.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The password for authentication.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Private key file: a file that contains an SSH private key, unencrypted, to
+	// authenticate with instead of or in addition to the password.
+	KeyFile string `protobuf:"bytes,4,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Skip verification of the host key against the known_hosts files.
+	SkipVerify bool `protobuf:"varint,5,opt,name=skip_verify,json=skipVerify,proto3" json:"skip_verify,omitempty"`
+	// Where to read the password from, instead of the password field.
+	PasswordFrom  *Secret `protobuf:"bytes,6,opt,name=password_from,json=passwordFrom,proto3" json:"password_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JumpHost) Reset() {
+	*x = JumpHost{}
+	mi := &file_binding_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JumpHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpHost) ProtoMessage() {}
+
+func (x *JumpHost) ProtoReflect() protoreflect.Message {
+	mi := &file_binding_proto_msgTypes[13]
 	if x != nil {
-		return x.MaxRecvMsgSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Options) GetMutualTls() bool {
+// Deprecated: Use JumpHost.ProtoReflect.Descriptor instead.
+func (*JumpHost) Descriptor() ([]byte, []int) {
+	return file_binding_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *JumpHost) GetTarget() string {
 	if x != nil {
-		return x.MutualTls
+		return x.Target
 	}
-	return false
+	return ""
 }
 
-func (x *Options) GetTrustBundleFile() string {
+func (x *JumpHost) GetUsername() string {
 	if x != nil {
-		return x.TrustBundleFile
+		return x.Username
 	}
 	return ""
 }
 
-func (x *Options) GetCertFile() string {
+func (x *JumpHost) GetPassword() string {
 	if x != nil {
-		return x.CertFile
+		return x.Password
 	}
 	return ""
 }
 
-func (x *Options) GetKeyFile() string {
+func (x *JumpHost) GetKeyFile() string {
 	if x != nil {
 		return x.KeyFile
 	}
 	return ""
 }
 
+func (x *JumpHost) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
+func (x *JumpHost) GetPasswordFrom() *Secret {
+	if x != nil {
+		return x.PasswordFrom
+	}
+	return nil
+}
+
 // Port binding.
 type Port struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -539,7 +1441,7 @@ type Port struct {
 
 func (x *Port) Reset() {
 	*x = Port{}
-	mi := &file_binding_proto_msgTypes[4]
+	mi := &file_binding_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +1453,7 @@ func (x *Port) String() string {
 func (*Port) ProtoMessage() {}
 
 func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[4]
+	mi := &file_binding_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1466,7 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Port.ProtoReflect.Descriptor instead.
 func (*Port) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{4}
+	return file_binding_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Port) GetId() string {
@@ -607,7 +1509,7 @@ type Link struct {
 
 func (x *Link) Reset() {
 	*x = Link{}
-	mi := &file_binding_proto_msgTypes[5]
+	mi := &file_binding_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -619,7 +1521,7 @@ func (x *Link) String() string {
 func (*Link) ProtoMessage() {}
 
 func (x *Link) ProtoReflect() protoreflect.Message {
-	mi := &file_binding_proto_msgTypes[5]
+	mi := &file_binding_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +1534,7 @@ func (x *Link) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Link.ProtoReflect.Descriptor instead.
 func (*Link) Descriptor() ([]byte, []int) {
-	return file_binding_proto_rawDescGZIP(), []int{5}
+	return file_binding_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Link) GetA() string {
@@ -672,84 +1574,207 @@ var file_binding_proto_rawDesc = string([]byte{
 	0x6d, 0x69, 0x63, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
 	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69,
-	0x6e, 0x6b, 0x73, 0x22, 0x7b, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x63, 0x6c, 0x69, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x6c, 0x69,
-	0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x67,
-	0x6e, 0x6d, 0x69, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x67, 0x6e, 0x6d, 0x69, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x69, 0x62, 0x69, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x72, 0x69, 0x62, 0x69, 0x46, 0x6c, 0x75, 0x73, 0x68,
-	0x22, 0xda, 0x05, 0x0a, 0x06, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52,
-	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x03, 0x73,
-	0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x73, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x04, 0x67, 0x6e,
-	0x6d, 0x69, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x67, 0x6e, 0x6d, 0x69, 0x12, 0x2f, 0x0a, 0x04, 0x67,
-	0x6e, 0x6f, 0x69, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x67, 0x6e, 0x6f, 0x69, 0x12, 0x2f, 0x0a, 0x04,
-	0x67, 0x6e, 0x73, 0x69, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x6b, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x6c, 0x69, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x6c,
+	0x69, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d,
+	0x67, 0x6e, 0x6d, 0x69, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x6e, 0x6d, 0x69, 0x53, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x69, 0x62, 0x69, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x67, 0x72, 0x69, 0x62, 0x69, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x12, 0x39, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x63, 0x6c, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x22, 0xce, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c,
+	0x0a, 0x11, 0x67, 0x6e, 0x6d, 0x69, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0f, 0x67, 0x6e, 0x6d,
+	0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x45, 0x0a, 0x0d,
+	0x67, 0x6e, 0x6f, 0x69, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x4e, 0x4f, 0x49, 0x46, 0x69, 0x6c,
+	0x65, 0x50, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x67, 0x6e, 0x6f, 0x69, 0x46, 0x69, 0x6c, 0x65,
+	0x50, 0x75, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x67, 0x6e, 0x6f, 0x69, 0x5f, 0x72, 0x65, 0x62, 0x6f,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x4e,
+	0x4f, 0x49, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x6e, 0x6f, 0x69,
+	0x52, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x70, 0x34, 0x72, 0x74, 0x5f, 0x63,
+	0x6c, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
 	0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x67, 0x6e, 0x73, 0x69, 0x12, 0x31, 0x0a,
-	0x05, 0x67, 0x72, 0x69, 0x62, 0x69, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x67, 0x72, 0x69, 0x62, 0x69,
-	0x12, 0x2f, 0x0a, 0x04, 0x70, 0x34, 0x72, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x50, 0x34, 0x52, 0x54, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x48, 0x00, 0x52, 0x09, 0x70, 0x34, 0x72,
+	0x74, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x6e, 0x73, 0x69, 0x5f, 0x61,
+	0x75, 0x74, 0x68, 0x7a, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0d, 0x67, 0x6e, 0x73, 0x69, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x3e, 0x0a, 0x0a, 0x67, 0x6e, 0x73, 0x69, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x7a, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x4e, 0x53, 0x49, 0x43, 0x65,
+	0x72, 0x74, 0x7a, 0x48, 0x00, 0x52, 0x09, 0x67, 0x6e, 0x73, 0x69, 0x43, 0x65, 0x72, 0x74, 0x7a,
+	0x12, 0x39, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x0b, 0x47, 0x4e, 0x4f, 0x49, 0x46, 0x69, 0x6c,
+	0x65, 0x50, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x26, 0x0a, 0x0a, 0x47, 0x4e, 0x4f, 0x49, 0x52, 0x65,
+	0x62, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49,
+	0x0a, 0x09, 0x50, 0x34, 0x52, 0x54, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x09, 0x47, 0x4e,
+	0x53, 0x49, 0x43, 0x65, 0x72, 0x74, 0x7a, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x73, 0x6c, 0x5f, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x73, 0x6c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65,
+	0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x4d, 0x0a, 0x0a, 0x67,
+	0x6e, 0x6d, 0x69, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x2e, 0x47, 0x6e, 0x6d, 0x69, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x67, 0x6e, 0x6d, 0x69, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x3c, 0x0a, 0x0e, 0x47, 0x6e,
+	0x6d, 0x69, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf4, 0x05, 0x0a, 0x06, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x70, 0x34, 0x72,
-	0x74, 0x12, 0x39, 0x0a, 0x09, 0x69, 0x78, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x11,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2d, 0x0a, 0x03, 0x73, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x73,
+	0x73, 0x68, 0x12, 0x2f, 0x0a, 0x04, 0x67, 0x6e, 0x6d, 0x69, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x67,
+	0x6e, 0x6d, 0x69, 0x12, 0x2f, 0x0a, 0x04, 0x67, 0x6e, 0x6f, 0x69, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04,
+	0x67, 0x6e, 0x6f, 0x69, 0x12, 0x2f, 0x0a, 0x04, 0x67, 0x6e, 0x73, 0x69, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x04, 0x67, 0x6e, 0x73, 0x69, 0x12, 0x31, 0x0a, 0x05, 0x67, 0x72, 0x69, 0x62, 0x69, 0x18, 0x0f,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x09, 0x69, 0x78, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2d, 0x0a, 0x03,
-	0x6f, 0x74, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x6f, 0x74, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x76,
-	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x6e,
-	0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x68,
-	0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f,
-	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xfd, 0x02,
-	0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6d, 0x73, 0x67, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x52,
-	0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x75,
-	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x72, 0x75,
-	0x73, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69,
-	0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74, 0x46, 0x69,
-	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x7a, 0x0a,
+	0x73, 0x52, 0x05, 0x67, 0x72, 0x69, 0x62, 0x69, 0x12, 0x2f, 0x0a, 0x04, 0x70, 0x34, 0x72, 0x74,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x04, 0x70, 0x34, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x09, 0x69, 0x78, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x69, 0x78, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2d, 0x0a, 0x03, 0x6f, 0x74, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03,
+	0x6f, 0x74, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x6e, 0x64, 0x61, 0x74, 0x72, 0x61, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x61, 0x72,
+	0x64, 0x77, 0x61, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f,
+	0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xc8, 0x05, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x5f, 0x6d, 0x73,
+	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x65, 0x72, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x76, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x73, 0x72, 0x76, 0x12, 0x3b, 0x0a, 0x0a, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4a, 0x75,
+	0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x09, 0x6a, 0x75, 0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3f, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x0c, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x75, 0x0a, 0x06, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x37,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x1d, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x22, 0x71, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x22, 0xd7, 0x01, 0x0a, 0x08, 0x4a, 0x75, 0x6d, 0x70, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x3f, 0x0a, 0x0d,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52,
+	0x0c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x7a, 0x0a,
 	0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x70, 0x65,
@@ -779,42 +1804,67 @@ func file_binding_proto_rawDescGZIP() []byte {
 	return file_binding_proto_rawDescData
 }
 
-var file_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_binding_proto_goTypes = []any{
 	(*Binding)(nil),          // 0: openconfig.testing.Binding
 	(*Configs)(nil),          // 1: openconfig.testing.Configs
-	(*Device)(nil),           // 2: openconfig.testing.Device
-	(*Options)(nil),          // 3: openconfig.testing.Options
-	(*Port)(nil),             // 4: openconfig.testing.Port
-	(*Link)(nil),             // 5: openconfig.testing.Link
-	(proto.Device_Vendor)(0), // 6: ondatra.Device.Vendor
-	(proto.Port_Speed)(0),    // 7: ondatra.Port.Speed
-	(proto.Port_Pmd)(0),      // 8: ondatra.Port.Pmd
+	(*ResetAction)(nil),      // 2: openconfig.testing.ResetAction
+	(*GNOIFilePut)(nil),      // 3: openconfig.testing.GNOIFilePut
+	(*GNOIReboot)(nil),       // 4: openconfig.testing.GNOIReboot
+	(*P4RTClear)(nil),        // 5: openconfig.testing.P4RTClear
+	(*GNSICertz)(nil),        // 6: openconfig.testing.GNSICertz
+	(*ResetVerify)(nil),      // 7: openconfig.testing.ResetVerify
+	(*Device)(nil),           // 8: openconfig.testing.Device
+	(*Options)(nil),          // 9: openconfig.testing.Options
+	(*Secret)(nil),           // 10: openconfig.testing.Secret
+	(*Command)(nil),          // 11: openconfig.testing.Command
+	(*Token)(nil),            // 12: openconfig.testing.Token
+	(*JumpHost)(nil),         // 13: openconfig.testing.JumpHost
+	(*Port)(nil),             // 14: openconfig.testing.Port
+	(*Link)(nil),             // 15: openconfig.testing.Link
+	nil,                      // 16: openconfig.testing.ResetVerify.GnmiStateEntry
+	(proto.Device_Vendor)(0), // 17: ondatra.Device.Vendor
+	(proto.Port_Speed)(0),    // 18: ondatra.Port.Speed
+	(proto.Port_Pmd)(0),      // 19: ondatra.Port.Pmd
 }
 var file_binding_proto_depIdxs = []int32{
-	2,  // 0: openconfig.testing.Binding.duts:type_name -> openconfig.testing.Device
-	2,  // 1: openconfig.testing.Binding.ates:type_name -> openconfig.testing.Device
-	3,  // 2: openconfig.testing.Binding.options:type_name -> openconfig.testing.Options
-	5,  // 3: openconfig.testing.Binding.links:type_name -> openconfig.testing.Link
-	3,  // 4: openconfig.testing.Device.options:type_name -> openconfig.testing.Options
-	4,  // 5: openconfig.testing.Device.ports:type_name -> openconfig.testing.Port
-	1,  // 6: openconfig.testing.Device.config:type_name -> openconfig.testing.Configs
-	3,  // 7: openconfig.testing.Device.ssh:type_name -> openconfig.testing.Options
-	3,  // 8: openconfig.testing.Device.gnmi:type_name -> openconfig.testing.Options
-	3,  // 9: openconfig.testing.Device.gnoi:type_name -> openconfig.testing.Options
-	3,  // 10: openconfig.testing.Device.gnsi:type_name -> openconfig.testing.Options
-	3,  // 11: openconfig.testing.Device.gribi:type_name -> openconfig.testing.Options
-	3,  // 12: openconfig.testing.Device.p4rt:type_name -> openconfig.testing.Options
-	3,  // 13: openconfig.testing.Device.ixnetwork:type_name -> openconfig.testing.Options
-	3,  // 14: openconfig.testing.Device.otg:type_name -> openconfig.testing.Options
-	6,  // 15: openconfig.testing.Device.vendor:type_name -> ondatra.Device.Vendor
-	7,  // 16: openconfig.testing.Port.speed:type_name -> ondatra.Port.Speed
-	8,  // 17: openconfig.testing.Port.pmd:type_name -> ondatra.Port.Pmd
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	8,  // 0: openconfig.testing.Binding.duts:type_name -> openconfig.testing.Device
+	8,  // 1: openconfig.testing.Binding.ates:type_name -> openconfig.testing.Device
+	9,  // 2: openconfig.testing.Binding.options:type_name -> openconfig.testing.Options
+	15, // 3: openconfig.testing.Binding.links:type_name -> openconfig.testing.Link
+	2,  // 4: openconfig.testing.Configs.actions:type_name -> openconfig.testing.ResetAction
+	3,  // 5: openconfig.testing.ResetAction.gnoi_file_put:type_name -> openconfig.testing.GNOIFilePut
+	4,  // 6: openconfig.testing.ResetAction.gnoi_reboot:type_name -> openconfig.testing.GNOIReboot
+	5,  // 7: openconfig.testing.ResetAction.p4rt_clear:type_name -> openconfig.testing.P4RTClear
+	6,  // 8: openconfig.testing.ResetAction.gnsi_certz:type_name -> openconfig.testing.GNSICertz
+	7,  // 9: openconfig.testing.ResetAction.verify:type_name -> openconfig.testing.ResetVerify
+	16, // 10: openconfig.testing.ResetVerify.gnmi_state:type_name -> openconfig.testing.ResetVerify.GnmiStateEntry
+	9,  // 11: openconfig.testing.Device.options:type_name -> openconfig.testing.Options
+	14, // 12: openconfig.testing.Device.ports:type_name -> openconfig.testing.Port
+	1,  // 13: openconfig.testing.Device.config:type_name -> openconfig.testing.Configs
+	9,  // 14: openconfig.testing.Device.ssh:type_name -> openconfig.testing.Options
+	9,  // 15: openconfig.testing.Device.gnmi:type_name -> openconfig.testing.Options
+	9,  // 16: openconfig.testing.Device.gnoi:type_name -> openconfig.testing.Options
+	9,  // 17: openconfig.testing.Device.gnsi:type_name -> openconfig.testing.Options
+	9,  // 18: openconfig.testing.Device.gribi:type_name -> openconfig.testing.Options
+	9,  // 19: openconfig.testing.Device.p4rt:type_name -> openconfig.testing.Options
+	9,  // 20: openconfig.testing.Device.ixnetwork:type_name -> openconfig.testing.Options
+	9,  // 21: openconfig.testing.Device.otg:type_name -> openconfig.testing.Options
+	17, // 22: openconfig.testing.Device.vendor:type_name -> ondatra.Device.Vendor
+	13, // 23: openconfig.testing.Options.jump_hosts:type_name -> openconfig.testing.JumpHost
+	10, // 24: openconfig.testing.Options.username_from:type_name -> openconfig.testing.Secret
+	10, // 25: openconfig.testing.Options.password_from:type_name -> openconfig.testing.Secret
+	12, // 26: openconfig.testing.Options.token:type_name -> openconfig.testing.Token
+	11, // 27: openconfig.testing.Secret.command:type_name -> openconfig.testing.Command
+	10, // 28: openconfig.testing.Token.source:type_name -> openconfig.testing.Secret
+	10, // 29: openconfig.testing.JumpHost.password_from:type_name -> openconfig.testing.Secret
+	18, // 30: openconfig.testing.Port.speed:type_name -> ondatra.Port.Speed
+	19, // 31: openconfig.testing.Port.pmd:type_name -> ondatra.Port.Pmd
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_binding_proto_init() }
@@ -822,13 +1872,27 @@ func file_binding_proto_init() {
 	if File_binding_proto != nil {
 		return
 	}
+	file_binding_proto_msgTypes[2].OneofWrappers = []any{
+		(*ResetAction_GnmiReplaceFile)(nil),
+		(*ResetAction_GnoiFilePut)(nil),
+		(*ResetAction_GnoiReboot)(nil),
+		(*ResetAction_P4RtClear)(nil),
+		(*ResetAction_GnsiAuthzFile)(nil),
+		(*ResetAction_GnsiCertz)(nil),
+		(*ResetAction_Verify)(nil),
+	}
+	file_binding_proto_msgTypes[10].OneofWrappers = []any{
+		(*Secret_Env)(nil),
+		(*Secret_File)(nil),
+		(*Secret_Command)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_binding_proto_rawDesc), len(file_binding_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},